@@ -0,0 +1,291 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+// Package alerting evaluates threshold rules over QingCloud monitor data,
+// and sends alarm notifications when the rules fire or resolve.
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/exporter"
+	"github.com/yunify/qingcloud-sdk-go/logger"
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+// Statuses of alerts, sent as the trigger and previous status of
+// notifications.
+const (
+	StatusOK    = "ok"
+	StatusAlarm = "alarm"
+)
+
+// DefaultEvaluatorInterval is the interval of an Evaluator without Interval.
+const DefaultEvaluatorInterval = time.Minute
+
+// ScrapeFunc returns the series to evaluate, such as Scrape of an
+// exporter.Exporter.
+type ScrapeFunc func(ctx context.Context) ([]*exporter.Series, error)
+
+// An Alert is a rule firing for a series.
+type Alert struct {
+	Rule       *Rule
+	Zone       string
+	ResourceID string
+	VxNetID    string
+	// Value is the latest value of the series.
+	Value float64
+	// Since is when the alert was notified.
+	Since time.Time
+}
+
+type alertKey struct {
+	rule       *Rule
+	zone       string
+	resourceID string
+	vxnetID    string
+}
+
+// resourceKey groups the transitions sent in one notification.
+type resourceKey struct {
+	zone         string
+	resourceID   string
+	resourceType string
+}
+
+type transition struct {
+	key    alertKey
+	status string
+	value  float64
+}
+
+// An Evaluator scrapes series every Interval and evaluates the rules over
+// them. It keeps the alerts firing between evaluations, and only notifies
+// the NotificationListID when an alert fires or resolves.
+// DefaultEvaluatorInterval is used if Interval is not positive.
+type Evaluator struct {
+	Rules               []*Rule
+	Scrape              ScrapeFunc
+	Interval            time.Duration
+	NotificationService *service.NotificationService
+	NotificationListID  string
+	UserID              string
+
+	mutex  sync.Mutex
+	alerts map[alertKey]*Alert
+	now    func() time.Time
+}
+
+// NewEvaluator create an Evaluator notifying the notification list of the
+// user through s.
+func NewEvaluator(s *service.NotificationService, notificationListID, userID string,
+	scrape ScrapeFunc, interval time.Duration, rules ...*Rule) *Evaluator {
+	return &Evaluator{
+		Rules:               rules,
+		Scrape:              scrape,
+		Interval:            interval,
+		NotificationService: s,
+		NotificationListID:  notificationListID,
+		UserID:              userID,
+		alerts:              map[alertKey]*Alert{},
+		now:                 time.Now,
+	}
+}
+
+// Run evaluates the rules immediately and then every Interval until the
+// context is done. Errors are logged and retried in next interval.
+func (e *Evaluator) Run(ctx context.Context) error {
+	interval := e.Interval
+	if interval <= 0 {
+		interval = DefaultEvaluatorInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := e.Evaluate(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Warn("Alert evaluation error : [%s]", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate scrapes the series once and evaluates the rules over them. The
+// fired and resolved alerts of each resource are sent in one notification,
+// and the alerts of a resource are kept unchanged if sending fails, so that
+// they are sent again in next evaluation. Series without data keep their
+// alerts unchanged.
+func (e *Evaluator) Evaluate(ctx context.Context) error {
+	for _, rule := range e.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	series, err := e.Scrape(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.mutex.Lock()
+	if e.alerts == nil {
+		e.alerts = map[alertKey]*Alert{}
+	}
+	transitions := map[resourceKey][]*transition{}
+	resources := []resourceKey{}
+	for _, rule := range e.Rules {
+		for _, s := range series {
+			if s.MeterID != rule.MeterID {
+				continue
+			}
+			firing, value, ok := rule.evaluate(s)
+			if !ok {
+				continue
+			}
+			key := alertKey{rule: rule, zone: s.Zone, resourceID: s.ResourceID, vxnetID: s.VxNetID}
+			if alert, ok := e.alerts[key]; ok {
+				alert.Value = value
+			}
+			if _, ok := e.alerts[key]; ok == firing {
+				continue
+			}
+			status := StatusOK
+			if firing {
+				status = StatusAlarm
+			}
+			resource := resourceKey{zone: s.Zone, resourceID: s.ResourceID, resourceType: rule.ResourceType}
+			if _, ok := transitions[resource]; !ok {
+				resources = append(resources, resource)
+			}
+			transitions[resource] = append(transitions[resource], &transition{key: key, status: status, value: value})
+		}
+	}
+	e.mutex.Unlock()
+
+	sort.Slice(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		if a.zone != b.zone {
+			return a.zone < b.zone
+		}
+		if a.resourceID != b.resourceID {
+			return a.resourceID < b.resourceID
+		}
+		return a.resourceType < b.resourceType
+	})
+	var firstErr error
+	for _, resource := range resources {
+		err := e.notify(ctx, resource, transitions[resource])
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		e.mutex.Lock()
+		for _, t := range transitions[resource] {
+			if t.status == StatusOK {
+				delete(e.alerts, t.key)
+				continue
+			}
+			e.alerts[t.key] = &Alert{
+				Rule:       t.key.rule,
+				Zone:       t.key.zone,
+				ResourceID: t.key.resourceID,
+				VxNetID:    t.key.vxnetID,
+				Value:      t.value,
+				Since:      e.clock(),
+			}
+		}
+		e.mutex.Unlock()
+	}
+	return firstErr
+}
+
+// Alerts returns the firing alerts sorted by resource and rule.
+func (e *Evaluator) Alerts() []Alert {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	alerts := []Alert{}
+	for _, alert := range e.alerts {
+		alerts = append(alerts, *alert)
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		a, b := alerts[i], alerts[j]
+		if a.Zone != b.Zone {
+			return a.Zone < b.Zone
+		}
+		if a.ResourceID != b.ResourceID {
+			return a.ResourceID < b.ResourceID
+		}
+		if a.Rule.Name != b.Rule.Name {
+			return a.Rule.Name < b.Rule.Name
+		}
+		return a.VxNetID < b.VxNetID
+	})
+	return alerts
+}
+
+func (e *Evaluator) clock() time.Time {
+	if e.now != nil {
+		return e.now()
+	}
+	return time.Now()
+}
+
+func (e *Evaluator) notify(ctx context.Context, resource resourceKey, transitions []*transition) error {
+	data := []*service.NotificationData{}
+	for _, t := range transitions {
+		prevStatus := StatusOK
+		if t.status == StatusOK {
+			prevStatus = StatusAlarm
+		}
+		rules := fmt.Sprintf("%s, value %g", t.key.rule, t.value)
+		if t.key.vxnetID != "" {
+			rules += ", vxnet " + t.key.vxnetID
+		}
+		data = append(data, &service.NotificationData{
+			AlarmPolicy:   service.String(t.key.rule.Name),
+			PrevStatus:    service.String(prevStatus),
+			TriggerStatus: service.String(t.status),
+			Rules:         service.String(rules),
+			UserID:        service.String(e.UserID),
+		})
+	}
+
+	input := &service.SendAlarmNotificationInput{
+		NotificationListID: service.String(e.NotificationListID),
+		UserID:             service.String(e.UserID),
+		ResourceID:         service.String(resource.resourceID),
+		NotificationData:   data,
+	}
+	if resource.resourceType != "" {
+		input.ResourceType = service.String(resource.resourceType)
+	}
+	_, err := e.NotificationService.SendAlarmNotificationWithContext(ctx, input)
+	return err
+}
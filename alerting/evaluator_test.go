@@ -0,0 +1,199 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/exporter"
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+var testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testSeries(resourceID string, values ...float64) *exporter.Series {
+	s := &exporter.Series{Zone: "pek3a", ResourceID: resourceID, MeterID: "cpu"}
+	for i, value := range values {
+		s.Points = append(s.Points, service.Point{
+			Time:   testStart.Add(time.Duration(i) * 5 * time.Minute),
+			Values: []float64{value},
+		})
+	}
+	return s
+}
+
+func TestRule_Evaluate(t *testing.T) {
+	rule := &Rule{MeterID: "cpu", Operator: GreaterThan, Threshold: 90, For: 15 * time.Minute}
+	testCases := []struct {
+		series *exporter.Series
+		firing bool
+		ok     bool
+	}{
+		{testSeries("i-1", 95, 95, 95, 95), true, true},
+		{testSeries("i-1", 10, 95, 95, 95), false, true},
+		{testSeries("i-1", 95, 95, 95, 95, 10), false, true},
+		{testSeries("i-1", 95, 95, 90, 95), false, true},
+		{testSeries("i-1"), false, false},
+	}
+	for _, testCase := range testCases {
+		firing, _, ok := rule.evaluate(testCase.series)
+		assert.Equal(t, testCase.firing, firing)
+		assert.Equal(t, testCase.ok, ok)
+	}
+
+	rule = &Rule{MeterID: "disk-os", Index: 1, Operator: LessThanOrEqual, Threshold: 0}
+	firing, value, ok := rule.evaluate(&exporter.Series{Points: []service.Point{
+		{Time: testStart, Values: []float64{10, 0}},
+	}})
+	assert.True(t, firing)
+	assert.True(t, ok)
+	assert.Equal(t, float64(0), value)
+
+	assert.NotNil(t, (&Rule{Operator: "=="}).Validate())
+	assert.Equal(t, "cpu[0] > 90 for 15m0s",
+		(&Rule{MeterID: "cpu", Operator: GreaterThan, Threshold: 90, For: 15 * time.Minute}).String())
+}
+
+func newTestNotificationService(t *testing.T, server *httptest.Server) *service.NotificationService {
+	conf, err := config.New("AccessKeyID", "SecretAccessKey")
+	assert.Nil(t, err)
+	u, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	conf.Protocol = u.Scheme
+	conf.Host = u.Hostname()
+	conf.Port, _ = strconv.Atoi(u.Port())
+	conf.ConnectionRetries = 0
+	qcService, err := service.Init(conf)
+	assert.Nil(t, err)
+	notificationService, err := qcService.Notification("pek3a")
+	assert.Nil(t, err)
+	return notificationService
+}
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	notifications := []url.Values{}
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		if status != http.StatusOK {
+			fmt.Fprint(w, `{"ret_code":5000,"message":"internal error"}`)
+			return
+		}
+		notifications = append(notifications, r.Form)
+		fmt.Fprint(w, `{"ret_code":0}`)
+	}))
+	defer server.Close()
+	notificationService := newTestNotificationService(t, server)
+
+	series := []*exporter.Series{}
+	rule := &Rule{Name: "high-cpu", MeterID: "cpu", Operator: GreaterThan, Threshold: 90,
+		For: 10 * time.Minute, ResourceType: "instance"}
+	e := NewEvaluator(notificationService, "nl-1", "usr-1", func(ctx context.Context) ([]*exporter.Series, error) {
+		return series, nil
+	}, time.Minute, rule)
+
+	series = []*exporter.Series{testSeries("i-1", 95, 95, 95), testSeries("i-2", 10, 95, 95)}
+	assert.Nil(t, e.Evaluate(context.Background()))
+	if assert.Len(t, notifications, 1) {
+		assert.Equal(t, "nl-1", notifications[0].Get("notification_list_id"))
+		assert.Equal(t, "usr-1", notifications[0].Get("user_id"))
+		assert.Equal(t, "i-1", notifications[0].Get("resource_id"))
+		assert.Equal(t, "instance", notifications[0].Get("resource_type"))
+		assert.Equal(t, "high-cpu", notifications[0].Get("notification_data.1.alarm_policy"))
+		assert.Equal(t, "alarm", notifications[0].Get("notification_data.1.trigger_status"))
+		assert.Equal(t, "ok", notifications[0].Get("notification_data.1.prev_status"))
+		assert.Equal(t, "cpu[0] > 90 for 10m0s, value 95", notifications[0].Get("notification_data.1.rules"))
+	}
+	alerts := e.Alerts()
+	if assert.Len(t, alerts, 1) {
+		assert.Equal(t, "i-1", alerts[0].ResourceID)
+	}
+
+	// Firing alerts are not notified again.
+	series = []*exporter.Series{testSeries("i-1", 95, 95, 95, 96)}
+	assert.Nil(t, e.Evaluate(context.Background()))
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, float64(96), e.Alerts()[0].Value)
+
+	// Alerts are kept if notifications fail.
+	status = http.StatusInternalServerError
+	series = []*exporter.Series{testSeries("i-1", 95, 95, 95, 50)}
+	assert.NotNil(t, e.Evaluate(context.Background()))
+	assert.Len(t, e.Alerts(), 1)
+
+	status = http.StatusOK
+	assert.Nil(t, e.Evaluate(context.Background()))
+	if assert.Len(t, notifications, 2) {
+		assert.Equal(t, "ok", notifications[1].Get("notification_data.1.trigger_status"))
+		assert.Equal(t, "alarm", notifications[1].Get("notification_data.1.prev_status"))
+	}
+	assert.Empty(t, e.Alerts())
+}
+
+func TestEvaluator_Literal(t *testing.T) {
+	notifications := []url.Values{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		notifications = append(notifications, r.Form)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ret_code":0}`)
+	}))
+	defer server.Close()
+
+	other := testSeries("i-1", 95)
+	other.Zone = "sh1a"
+	e := &Evaluator{
+		Rules: []*Rule{
+			{Name: "high-cpu", MeterID: "cpu", Operator: GreaterThan, Threshold: 90, ResourceType: "instance"},
+			{Name: "cpu-of-router", MeterID: "cpu", Operator: GreaterThan, Threshold: 80, ResourceType: "router"},
+		},
+		Scrape: func(ctx context.Context) ([]*exporter.Series, error) {
+			return []*exporter.Series{testSeries("i-1", 95), other}, nil
+		},
+		NotificationService: newTestNotificationService(t, server),
+		NotificationListID:  "nl-1",
+	}
+
+	// Alerts of the same resource ID are grouped by zone and resource type.
+	assert.Nil(t, e.Evaluate(context.Background()))
+	assert.Len(t, e.Alerts(), 4)
+	if assert.Len(t, notifications, 4) {
+		types := []string{}
+		for _, notification := range notifications {
+			assert.Equal(t, "i-1", notification.Get("resource_id"))
+			assert.Equal(t, "", notification.Get("notification_data.2.alarm_policy"))
+			types = append(types, notification.Get("resource_type"))
+		}
+		assert.Equal(t, []string{"instance", "router", "instance", "router"}, types)
+	}
+	assert.False(t, e.Alerts()[0].Since.IsZero())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, e.Run(ctx))
+}
@@ -0,0 +1,110 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package alerting
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/exporter"
+)
+
+// Operators comparing the values of meters with thresholds.
+const (
+	GreaterThan        = ">"
+	GreaterThanOrEqual = ">="
+	LessThan           = "<"
+	LessThanOrEqual    = "<="
+)
+
+// A Rule fires for a series of the meter when its values compare true with
+// the threshold for the duration, such as cpu > 90 for 15 minutes.
+type Rule struct {
+	// Name is sent as the alarm policy of notifications.
+	Name    string
+	MeterID string
+	// Index of the value of multi-value meters, such as 1 for disk writes.
+	Index     int
+	Operator  string
+	Threshold float64
+	// For is how long the condition must hold before the rule fires, the
+	// window of the scraped series should be longer than it.
+	For time.Duration
+	// ResourceType is sent in notifications, such as "instance".
+	ResourceType string
+}
+
+// Validate checks the operator of the rule.
+func (r *Rule) Validate() error {
+	switch r.Operator {
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+		return nil
+	}
+	return fmt.Errorf("rule %s: unknown operator \"%s\"", r.Name, r.Operator)
+}
+
+// String describes the condition of the rule.
+func (r *Rule) String() string {
+	return fmt.Sprintf("%s[%d] %s %g for %s", r.MeterID, r.Index, r.Operator, r.Threshold, r.For)
+}
+
+func (r *Rule) match(value float64) bool {
+	if math.IsNaN(value) {
+		return false
+	}
+	switch r.Operator {
+	case GreaterThan:
+		return value > r.Threshold
+	case GreaterThanOrEqual:
+		return value >= r.Threshold
+	case LessThan:
+		return value < r.Threshold
+	case LessThanOrEqual:
+		return value <= r.Threshold
+	}
+	return false
+}
+
+// evaluate returns whether the rule fires for the series, and the latest
+// value of the series. The condition holds since the first point of the
+// run of matching points ending at the latest point.
+func (r *Rule) evaluate(s *exporter.Series) (firing bool, value float64, ok bool) {
+	if len(s.Points) == 0 {
+		return false, 0, false
+	}
+	latest := s.Points[len(s.Points)-1]
+	if r.Index >= len(latest.Values) {
+		return false, 0, false
+	}
+	value = latest.Values[r.Index]
+	if math.IsNaN(value) {
+		return false, value, false
+	}
+
+	since := latest.Time
+	matched := false
+	for i := len(s.Points) - 1; i >= 0; i-- {
+		point := s.Points[i]
+		if r.Index >= len(point.Values) || !r.match(point.Values[r.Index]) {
+			break
+		}
+		since = point.Time
+		matched = true
+	}
+	return matched && latest.Time.Sub(since) >= r.For, value, true
+}
@@ -46,6 +46,8 @@ const (
 	JobStatusPending = "pending"
 	//JobStatusWorking working
 	JobStatusWorking = "working"
+	//JobStatusDoneWithFailure done with failure
+	JobStatusDoneWithFailure = "done with failure"
 
 	defaultOpTimeout    = 180 * time.Second
 	defaultWaitInterval = 10 * time.Second
@@ -64,7 +66,7 @@ type QingCloudClient interface {
 
 // NewClient return a new QingCloudClient
 func NewClient(config *config.Config, zone string) (QingCloudClient, error) {
-	qcService, err := service.Init(config)
+	qcService, jobService, err := initServices(config, zone)
 	if err != nil {
 		return nil, err
 	}
@@ -72,16 +74,13 @@ func NewClient(config *config.Config, zone string) (QingCloudClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	jobService, err := qcService.Job(zone)
-	if err != nil {
-		return nil, err
-	}
 
 	c := &client{
 		InstanceService:  instanceService,
 		JobService:       jobService,
 		OperationTimeout: defaultOpTimeout,
 		WaitInterval:     defaultWaitInterval,
+		jobWatcher:       NewJobWatcher(jobService, defaultWaitInterval),
 		zone:             zone,
 	}
 	return c, nil
@@ -92,6 +91,7 @@ type client struct {
 	JobService       *service.JobService
 	OperationTimeout time.Duration
 	WaitInterval     time.Duration
+	jobWatcher       *JobWatcher
 	zone             string
 }
 
@@ -102,11 +102,10 @@ func (c *client) RunInstance(input *service.RunInstancesInput) (*service.Instanc
 	if err != nil {
 		return nil, err
 	}
-	if len(output.Instances) == 0 {
+	if len(output.Instances) == 0 || service.StringValue(output.Instances[0]) == "" {
 		return nil, errors.New("Create instance response error")
 	}
-	jobID := output.JobID
-	jobErr := c.waitJob(*jobID)
+	jobErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
 	if jobErr != nil {
 		return nil, jobErr
 	}
@@ -142,8 +141,7 @@ func (c *client) StartInstance(instanceID string) error {
 	if err != nil {
 		return err
 	}
-	jobID := output.JobID
-	waitErr := c.waitJob(*jobID)
+	waitErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
 	if waitErr != nil {
 		return waitErr
 	}
@@ -164,8 +162,7 @@ func (c *client) StopInstance(instanceID string, force bool) error {
 	if err != nil {
 		return err
 	}
-	jobID := output.JobID
-	waitErr := c.waitJob(*jobID)
+	waitErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
 	if waitErr != nil {
 		return waitErr
 	}
@@ -180,8 +177,7 @@ func (c *client) RestartInstance(instanceID string) error {
 	if err != nil {
 		return err
 	}
-	jobID := output.JobID
-	waitErr := c.waitJob(*jobID)
+	waitErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
 	if waitErr != nil {
 		return waitErr
 	}
@@ -196,8 +192,7 @@ func (c *client) TerminateInstance(instanceID string) error {
 	if err != nil {
 		return err
 	}
-	jobID := output.JobID
-	waitErr := c.waitJob(*jobID)
+	waitErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
 	if waitErr != nil {
 		return waitErr
 	}
@@ -205,10 +200,6 @@ func (c *client) TerminateInstance(instanceID string) error {
 	return err
 }
 
-func (c *client) waitJob(jobID string) error {
-	return WaitJob(c.JobService, jobID, c.OperationTimeout, c.WaitInterval)
-}
-
 // WaitInstanceStatus
 func (c *client) WaitInstanceStatus(instanceID string, status string) (*service.Instance, error) {
 	return WaitInstanceStatus(c.InstanceService, instanceID, status, c.OperationTimeout, c.WaitInterval)
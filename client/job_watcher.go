@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/logger"
+	"github.com/yunify/qingcloud-sdk-go/service"
+	"github.com/yunify/qingcloud-sdk-go/utils"
+)
+
+// DefaultJobWatcherBatchSize is the max number of jobs in one DescribeJobs call
+const DefaultJobWatcherBatchSize = 100
+
+// JobError is returned when a job failed or done with failure
+type JobError struct {
+	JobID  string
+	Status string
+}
+
+// Error returns the description of JobError
+func (e *JobError) Error() string {
+	return fmt.Sprintf("Job [%s] %s", e.JobID, e.Status)
+}
+
+// JobResult is the result of a watched job, Err is nil if the job is successful
+type JobResult struct {
+	JobID string
+	Job   *service.Job
+	Err   error
+}
+
+// JobWatcher polls all the watched jobs with batched DescribeJobs calls,
+// it polls in background while there are jobs being watched, and the
+// DescribeJobs call in flight is canceled once no job is watched
+type JobWatcher struct {
+	JobService   *service.JobService
+	WaitInterval time.Duration
+	BatchSize    int
+
+	mutex    sync.Mutex
+	watchers map[string][]chan *JobResult
+	polling  bool
+	cancel   context.CancelFunc
+}
+
+// NewJobWatcher return a new JobWatcher polling every waitInterval
+func NewJobWatcher(jobService *service.JobService, waitInterval time.Duration) *JobWatcher {
+	return &JobWatcher{
+		JobService:   jobService,
+		WaitInterval: waitInterval,
+		BatchSize:    DefaultJobWatcherBatchSize,
+		watchers:     map[string][]chan *JobResult{},
+	}
+}
+
+// Watch return a channel receiving the result of the job once it's finished
+func (w *JobWatcher) Watch(jobID string) <-chan *JobResult {
+	ch := make(chan *JobResult, 1)
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.watchers[jobID] = append(w.watchers[jobID], ch)
+	if !w.polling {
+		w.polling = true
+		go w.poll()
+	}
+	return ch
+}
+
+// Wait wait the job with this jobID finish or the context done
+func (w *JobWatcher) Wait(ctx context.Context, jobID string) error {
+	ch := w.Watch(jobID)
+	select {
+	case result := <-ch:
+		return result.Err
+	case <-ctx.Done():
+		w.unwatch(jobID, ch)
+		return ctx.Err()
+	}
+}
+
+// WaitWithTimeout wait the job with this jobID finish, it return a
+// *utils.TimeoutError if the job is not finished in timeout, like WaitJob
+func (w *JobWatcher) WaitWithTimeout(jobID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := w.Wait(ctx, jobID)
+	if err == context.DeadlineExceeded {
+		return utils.NewTimeoutError(timeout)
+	}
+	return err
+}
+
+func (w *JobWatcher) unwatch(jobID string, ch <-chan *JobResult) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	watchers := w.watchers[jobID]
+	for i, watcher := range watchers {
+		if watcher == ch {
+			watchers = append(watchers[:i], watchers[i+1:]...)
+			break
+		}
+	}
+	if len(watchers) == 0 {
+		delete(w.watchers, jobID)
+	} else {
+		w.watchers[jobID] = watchers
+	}
+	if len(w.watchers) == 0 && w.cancel != nil {
+		w.cancel()
+	}
+}
+
+func (w *JobWatcher) deliver(result *JobResult) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for _, watcher := range w.watchers[result.JobID] {
+		watcher <- result
+	}
+	delete(w.watchers, result.JobID)
+}
+
+// jobIDs return the watched job IDs and the context of checking them, which
+// is canceled once no job is watched. It stop polling if there is none
+func (w *JobWatcher) jobIDs() ([]*string, context.Context) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+	jobIDs := []*string{}
+	for jobID := range w.watchers {
+		jobID := jobID
+		jobIDs = append(jobIDs, &jobID)
+	}
+	if len(jobIDs) == 0 {
+		w.polling = false
+		return jobIDs, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	return jobIDs, ctx
+}
+
+func (w *JobWatcher) poll() {
+	batchSize := w.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultJobWatcherBatchSize
+	}
+
+	for {
+		time.Sleep(w.WaitInterval)
+
+		jobIDs, ctx := w.jobIDs()
+		if len(jobIDs) == 0 {
+			return
+		}
+		for start := 0; start < len(jobIDs) && ctx.Err() == nil; start += batchSize {
+			end := start + batchSize
+			if end > len(jobIDs) {
+				end = len(jobIDs)
+			}
+			w.check(ctx, jobIDs[start:end])
+		}
+	}
+}
+
+func (w *JobWatcher) check(ctx context.Context, jobIDs []*string) {
+	limit := len(jobIDs)
+	output, err := w.JobService.DescribeJobsWithContext(ctx, &service.DescribeJobsInput{
+		Jobs:  jobIDs,
+		Limit: &limit,
+	})
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		//network or api error, not considered job fail.
+		logger.Warn("DescribeJobs error : [%s]", err.Error())
+		return
+	}
+
+	found := map[string]bool{}
+	for _, j := range output.JobSet {
+		if j.JobID == nil {
+			continue
+		}
+		jobID := *j.JobID
+		found[jobID] = true
+		if j.Status == nil {
+			logger.Error("Job [%s] status is nil ", jobID)
+			continue
+		}
+
+		switch *j.Status {
+		case JobStatusPending, JobStatusWorking:
+		case JobStatusSuccessful:
+			w.deliver(&JobResult{JobID: jobID, Job: j})
+		case JobStatusFailed, JobStatusDoneWithFailure:
+			w.deliver(&JobResult{JobID: jobID, Job: j, Err: &JobError{JobID: jobID, Status: *j.Status}})
+		default:
+			logger.Error("Unknow status [%s] for job [%s]", *j.Status, jobID)
+		}
+	}
+
+	for _, jobID := range jobIDs {
+		if !found[*jobID] {
+			w.deliver(&JobResult{JobID: *jobID, Err: fmt.Errorf("Can not find job [%s]", *jobID)})
+		}
+	}
+}
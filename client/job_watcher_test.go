@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/service"
+	"github.com/yunify/qingcloud-sdk-go/utils"
+)
+
+func newTestService(t *testing.T, handler http.HandlerFunc) (*service.QingCloudService, func()) {
+	server := httptest.NewServer(handler)
+
+	conf, err := config.New("AccessKeyID", "SecretAccessKey")
+	assert.Nil(t, err)
+	u, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	conf.Protocol = u.Scheme
+	conf.Host = u.Hostname()
+	conf.Port, _ = strconv.Atoi(u.Port())
+
+	qcService, err := service.Init(conf)
+	assert.Nil(t, err)
+	return qcService, server.Close
+}
+
+func TestJobWatcher(t *testing.T) {
+	statuses := map[string]string{
+		"j-successful": JobStatusSuccessful,
+		"j-failure":    JobStatusDoneWithFailure,
+		"j-working":    JobStatusWorking,
+	}
+	calls := int32(0)
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		r.ParseForm()
+		jobSet := []string{}
+		for key, values := range r.Form {
+			if !strings.HasPrefix(key, "jobs.") {
+				continue
+			}
+			if status, ok := statuses[values[0]]; ok {
+				jobSet = append(jobSet, fmt.Sprintf(`{"job_id":"%s","status":"%s"}`, values[0], status))
+			}
+		}
+		fmt.Fprintf(w, `{"action":"DescribeJobsResponse","ret_code":0,"total_count":%d,"job_set":[%s]}`,
+			len(jobSet), strings.Join(jobSet, ","))
+	})
+	defer closeServer()
+
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	watcher := NewJobWatcher(jobService, 10*time.Millisecond)
+	successful := watcher.Watch("j-successful")
+	failure := watcher.Watch("j-failure")
+	missing := watcher.Watch("j-missing")
+
+	result := <-successful
+	assert.Nil(t, result.Err)
+	assert.Equal(t, JobStatusSuccessful, *result.Job.Status)
+
+	result = <-failure
+	jobErr := &JobError{}
+	assert.True(t, errors.As(result.Err, &jobErr))
+	assert.Equal(t, JobStatusDoneWithFailure, jobErr.Status)
+
+	result = <-missing
+	assert.NotNil(t, result.Err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = watcher.Wait(ctx, "j-working")
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	err = watcher.WaitWithTimeout("j-working", 50*time.Millisecond)
+	timeoutErr := &utils.TimeoutError{}
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, 50*time.Millisecond, timeoutErr.Timeout())
+}
+
+func TestJobWatcher_CancelDescribeJobs(t *testing.T) {
+	started := make(chan struct{}, 1)
+	canceled := make(chan struct{}, 1)
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		select {
+		case <-r.Context().Done():
+			canceled <- struct{}{}
+		case <-time.After(5 * time.Second):
+		}
+	})
+	defer closeServer()
+
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	watcher := NewJobWatcher(jobService, 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	assert.Equal(t, context.Canceled, watcher.Wait(ctx, "j-slow"))
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Error("DescribeJobs in flight is not canceled")
+	}
+}
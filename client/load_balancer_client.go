@@ -0,0 +1,453 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+// LoadBalancerSpec desired state of a load balancer
+type LoadBalancerSpec struct {
+	// LoadBalancerID of the load balancer to reconcile, a new load balancer
+	// is created if it's empty
+	LoadBalancerID   string
+	LoadBalancerName string
+	LoadBalancerType int
+	// EIPs of the load balancer, or VxNetID for a load balancer in vxnet
+	EIPs            []string
+	VxNetID         string
+	SecurityGroupID string
+	Listeners       []*LoadBalancerListenerSpec
+}
+
+// LoadBalancerListenerSpec desired state of a listener, listeners are matched by ListenerPort.
+// The server side defaults are kept for the other fields if they are not set
+type LoadBalancerListenerSpec struct {
+	ListenerName       string
+	ListenerPort       int
+	ListenerProtocol   string
+	BackendProtocol    string
+	BalanceMode        string
+	HealthyCheckMethod string
+	HealthyCheckOption string
+	Backends           []*LoadBalancerBackendSpec
+}
+
+// LoadBalancerBackendSpec desired state of a backend, backends are matched by ResourceID and Port.
+// The server side defaults are kept for BackendName and Weight if they are not set
+type LoadBalancerBackendSpec struct {
+	BackendName string
+	ResourceID  string
+	Port        int
+	Weight      int
+}
+
+// LoadBalancerClient QingCloud load balancer reconciliation client
+type LoadBalancerClient interface {
+	EnsureLoadBalancer(spec *LoadBalancerSpec) (*service.LoadBalancer, error)
+	DescribeLoadBalancer(loadBalancerID string) (*service.LoadBalancer, error)
+}
+
+// NewLoadBalancerClient return a new LoadBalancerClient
+func NewLoadBalancerClient(config *config.Config, zone string) (LoadBalancerClient, error) {
+	qcService, jobService, err := initServices(config, zone)
+	if err != nil {
+		return nil, err
+	}
+	lbService, err := qcService.LoadBalancer(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &loadBalancerClient{
+		LoadBalancerService: lbService,
+		JobService:          jobService,
+		OperationTimeout:    defaultOpTimeout,
+		WaitInterval:        defaultWaitInterval,
+		jobWatcher:          NewJobWatcher(jobService, defaultWaitInterval),
+		zone:                zone,
+	}
+	return c, nil
+}
+
+type loadBalancerClient struct {
+	LoadBalancerService *service.LoadBalancerService
+	JobService          *service.JobService
+	OperationTimeout    time.Duration
+	WaitInterval        time.Duration
+	jobWatcher          *JobWatcher
+	zone                string
+}
+
+// DescribeLoadBalancer
+func (c *loadBalancerClient) DescribeLoadBalancer(loadBalancerID string) (*service.LoadBalancer, error) {
+	lb, err := describeLoadBalancer(context.Background(), c.LoadBalancerService, loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+	if lb == nil {
+		return nil, fmt.Errorf("LoadBalancer with id [%s] not exist", loadBalancerID)
+	}
+	return lb, nil
+}
+
+// EnsureLoadBalancer create the load balancer if needed, apply the minimal
+// changes of EIPs, listeners and backends to reach the spec, and update the
+// load balancer if anything changed or it's not applied yet
+func (c *loadBalancerClient) EnsureLoadBalancer(spec *LoadBalancerSpec) (*service.LoadBalancer, error) {
+	loadBalancerID := spec.LoadBalancerID
+	if loadBalancerID == "" {
+		id, err := c.createLoadBalancer(spec)
+		if err != nil {
+			return nil, err
+		}
+		loadBalancerID = id
+	}
+
+	lb, err := c.DescribeLoadBalancer(loadBalancerID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := c.ensureEIPs(lb, spec.EIPs)
+	if err != nil {
+		return nil, err
+	}
+	listenersChanged, err := c.ensureListeners(loadBalancerID, spec.Listeners)
+	if err != nil {
+		return nil, err
+	}
+	changed = changed || listenersChanged
+
+	if !changed && service.IntValue(lb.IsApplied) == 1 {
+		return lb, nil
+	}
+
+	output, err := c.LoadBalancerService.UpdateLoadBalancers(&service.UpdateLoadBalancersInput{
+		LoadBalancers: []*string{&loadBalancerID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return WaitLoadBalancerStatus(c.LoadBalancerService, loadBalancerID, LoadBalancerStatusActive, c.OperationTimeout, c.WaitInterval)
+}
+
+func (c *loadBalancerClient) createLoadBalancer(spec *LoadBalancerSpec) (string, error) {
+	input := &service.CreateLoadBalancerInput{
+		EIPs:             service.StringSlice(spec.EIPs),
+		LoadBalancerType: &spec.LoadBalancerType,
+	}
+	if spec.LoadBalancerName != "" {
+		input.LoadBalancerName = &spec.LoadBalancerName
+	}
+	if spec.VxNetID != "" {
+		input.VxNet = &spec.VxNetID
+	}
+	if spec.SecurityGroupID != "" {
+		input.SecurityGroup = &spec.SecurityGroupID
+	}
+	output, err := c.LoadBalancerService.CreateLoadBalancer(input)
+	if err != nil {
+		return "", err
+	}
+	loadBalancerID := service.StringValue(output.LoadBalancerID)
+	if loadBalancerID == "" {
+		return "", errors.New("Create load balancer response error")
+	}
+	err = waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+	if err != nil {
+		return "", err
+	}
+	_, err = WaitLoadBalancerStatus(c.LoadBalancerService, loadBalancerID, LoadBalancerStatusActive, c.OperationTimeout, c.WaitInterval)
+	if err != nil {
+		return "", err
+	}
+	return loadBalancerID, nil
+}
+
+func (c *loadBalancerClient) ensureEIPs(lb *service.LoadBalancer, eips []string) (bool, error) {
+	desired := map[string]bool{}
+	for _, eip := range eips {
+		desired[eip] = true
+	}
+	current := map[string]bool{}
+	extra := []*string{}
+	for _, eip := range lb.EIPs {
+		eipID := service.StringValue(eip.EIPID)
+		current[eipID] = true
+		if !desired[eipID] {
+			extra = append(extra, eip.EIPID)
+		}
+	}
+	missing := []*string{}
+	for _, eip := range eips {
+		if !current[eip] {
+			missing = append(missing, service.String(eip))
+		}
+	}
+
+	if len(extra) > 0 {
+		output, err := c.LoadBalancerService.DissociateEIPsFromLoadBalancer(&service.DissociateEIPsFromLoadBalancerInput{
+			EIPs:         extra,
+			LoadBalancer: lb.LoadBalancerID,
+		})
+		if err != nil {
+			return false, err
+		}
+		err = waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(missing) > 0 {
+		output, err := c.LoadBalancerService.AssociateEIPsToLoadBalancer(&service.AssociateEIPsToLoadBalancerInput{
+			EIPs:         missing,
+			LoadBalancer: lb.LoadBalancerID,
+		})
+		if err != nil {
+			return false, err
+		}
+		err = waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+		if err != nil {
+			return false, err
+		}
+	}
+	return len(extra) > 0 || len(missing) > 0, nil
+}
+
+func (c *loadBalancerClient) ensureListeners(loadBalancerID string, specs []*LoadBalancerListenerSpec) (bool, error) {
+	ctx := context.Background()
+	listeners, err := c.LoadBalancerService.DescribeLoadBalancerListenersAll(ctx, &service.DescribeLoadBalancerListenersInput{
+		LoadBalancer: &loadBalancerID,
+	})
+	if err != nil {
+		return false, err
+	}
+	backends, err := c.LoadBalancerService.DescribeLoadBalancerBackendsAll(ctx, &service.DescribeLoadBalancerBackendsInput{
+		LoadBalancer: &loadBalancerID,
+	})
+	if err != nil {
+		return false, err
+	}
+	listenerBackends := map[string][]*service.LoadBalancerBackend{}
+	for _, backend := range backends {
+		listenerID := service.StringValue(backend.LoadBalancerListenerID)
+		listenerBackends[listenerID] = append(listenerBackends[listenerID], backend)
+	}
+
+	current := map[int]*service.LoadBalancerListener{}
+	for _, listener := range listeners {
+		current[service.IntValue(listener.ListenerPort)] = listener
+	}
+
+	changed := false
+	deleted := []*string{}
+	added := []*LoadBalancerListenerSpec{}
+	desired := map[int]bool{}
+	for _, spec := range specs {
+		desired[spec.ListenerPort] = true
+		listener, ok := current[spec.ListenerPort]
+		if ok && !listenerRecreated(listener, spec) {
+			modified, err := c.ensureListener(listener, spec, listenerBackends[service.StringValue(listener.LoadBalancerListenerID)])
+			if err != nil {
+				return false, err
+			}
+			changed = changed || modified
+			continue
+		}
+		if ok {
+			deleted = append(deleted, listener.LoadBalancerListenerID)
+		}
+		added = append(added, spec)
+	}
+	for port, listener := range current {
+		if !desired[port] {
+			deleted = append(deleted, listener.LoadBalancerListenerID)
+		}
+	}
+
+	if len(deleted) > 0 {
+		_, err := c.LoadBalancerService.DeleteLoadBalancerListeners(&service.DeleteLoadBalancerListenersInput{
+			LoadBalancerListeners: deleted,
+		})
+		if err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	if len(added) > 0 {
+		input := &service.AddLoadBalancerListenersInput{LoadBalancer: &loadBalancerID}
+		for _, spec := range added {
+			input.Listeners = append(input.Listeners, newListener(spec))
+		}
+		output, err := c.LoadBalancerService.AddLoadBalancerListeners(input)
+		if err != nil {
+			return false, err
+		}
+		if len(output.LoadBalancerListeners) != len(added) {
+			return false, errors.New("Add load balancer listeners response error")
+		}
+		for i, spec := range added {
+			listenerID := service.StringValue(output.LoadBalancerListeners[i])
+			if listenerID == "" {
+				return false, errors.New("Add load balancer listeners response error")
+			}
+			_, err := c.ensureBackends(listenerID, spec.Backends, nil)
+			if err != nil {
+				return false, err
+			}
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// listenerRecreated returns whether the listener should be recreated, as
+// the protocols can not be modified
+func listenerRecreated(listener *service.LoadBalancerListener, spec *LoadBalancerListenerSpec) bool {
+	return (spec.ListenerProtocol != "" && service.StringValue(listener.ListenerProtocol) != spec.ListenerProtocol) ||
+		(spec.BackendProtocol != "" && service.StringValue(listener.BackendProtocol) != spec.BackendProtocol)
+}
+
+func newListener(spec *LoadBalancerListenerSpec) *service.LoadBalancerListener {
+	listener := &service.LoadBalancerListener{
+		ListenerPort: service.Int(spec.ListenerPort),
+	}
+	if spec.ListenerProtocol != "" {
+		listener.ListenerProtocol = service.String(spec.ListenerProtocol)
+	}
+	if spec.ListenerName != "" {
+		listener.LoadBalancerListenerName = service.String(spec.ListenerName)
+	}
+	if spec.BackendProtocol != "" {
+		listener.BackendProtocol = service.String(spec.BackendProtocol)
+	}
+	if spec.BalanceMode != "" {
+		listener.BalanceMode = service.String(spec.BalanceMode)
+	}
+	if spec.HealthyCheckMethod != "" {
+		listener.HealthyCheckMethod = service.String(spec.HealthyCheckMethod)
+	}
+	if spec.HealthyCheckOption != "" {
+		listener.HealthyCheckOption = service.String(spec.HealthyCheckOption)
+	}
+	return listener
+}
+
+func (c *loadBalancerClient) ensureListener(listener *service.LoadBalancerListener, spec *LoadBalancerListenerSpec, backends []*service.LoadBalancerBackend) (bool, error) {
+	input := &service.ModifyLoadBalancerListenerAttributesInput{
+		LoadBalancerListener: listener.LoadBalancerListenerID,
+	}
+	modified := false
+	if spec.ListenerName != "" && spec.ListenerName != service.StringValue(listener.LoadBalancerListenerName) {
+		input.LoadBalancerListenerName = service.String(spec.ListenerName)
+		modified = true
+	}
+	if spec.BalanceMode != "" && spec.BalanceMode != service.StringValue(listener.BalanceMode) {
+		input.BalanceMode = service.String(spec.BalanceMode)
+		modified = true
+	}
+	if spec.HealthyCheckMethod != "" && spec.HealthyCheckMethod != service.StringValue(listener.HealthyCheckMethod) {
+		input.HealthyCheckMethod = service.String(spec.HealthyCheckMethod)
+		modified = true
+	}
+	if spec.HealthyCheckOption != "" && spec.HealthyCheckOption != service.StringValue(listener.HealthyCheckOption) {
+		input.HealthyCheckOption = service.String(spec.HealthyCheckOption)
+		modified = true
+	}
+	if modified {
+		_, err := c.LoadBalancerService.ModifyLoadBalancerListenerAttributes(input)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	backendsChanged, err := c.ensureBackends(service.StringValue(listener.LoadBalancerListenerID), spec.Backends, backends)
+	if err != nil {
+		return false, err
+	}
+	return modified || backendsChanged, nil
+}
+
+func backendKey(resourceID string, port int) string {
+	return fmt.Sprintf("%s:%d", resourceID, port)
+}
+
+func newBackend(spec *LoadBalancerBackendSpec) *service.LoadBalancerBackend {
+	backend := &service.LoadBalancerBackend{
+		ResourceID: service.String(spec.ResourceID),
+		Port:       service.Int(spec.Port),
+	}
+	if spec.BackendName != "" {
+		backend.LoadBalancerBackendName = service.String(spec.BackendName)
+	}
+	if spec.Weight != 0 {
+		backend.Weight = service.Int(spec.Weight)
+	}
+	return backend
+}
+
+func (c *loadBalancerClient) ensureBackends(listenerID string, specs []*LoadBalancerBackendSpec, backends []*service.LoadBalancerBackend) (bool, error) {
+	current := map[string]*service.LoadBalancerBackend{}
+	for _, backend := range backends {
+		current[backendKey(service.StringValue(backend.ResourceID), service.IntValue(backend.Port))] = backend
+	}
+
+	changed := false
+	desired := map[string]bool{}
+	added := []*service.LoadBalancerBackend{}
+	for _, spec := range specs {
+		key := backendKey(spec.ResourceID, spec.Port)
+		desired[key] = true
+		backend, ok := current[key]
+		if !ok {
+			added = append(added, newBackend(spec))
+			continue
+		}
+		if spec.Weight != 0 && spec.Weight != service.IntValue(backend.Weight) {
+			_, err := c.LoadBalancerService.ModifyLoadBalancerBackendAttributes(&service.ModifyLoadBalancerBackendAttributesInput{
+				LoadBalancerBackend: backend.LoadBalancerBackendID,
+				Weight:              service.Int(spec.Weight),
+			})
+			if err != nil {
+				return false, err
+			}
+			changed = true
+		}
+	}
+
+	deleted := []*string{}
+	for key, backend := range current {
+		if !desired[key] {
+			deleted = append(deleted, backend.LoadBalancerBackendID)
+		}
+	}
+	if len(deleted) > 0 {
+		_, err := c.LoadBalancerService.DeleteLoadBalancerBackends(&service.DeleteLoadBalancerBackendsInput{
+			LoadBalancerBackends: deleted,
+		})
+		if err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	if len(added) > 0 {
+		_, err := c.LoadBalancerService.AddLoadBalancerBackends(&service.AddLoadBalancerBackendsInput{
+			LoadBalancerListener: &listenerID,
+			Backends:             added,
+		})
+		if err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+func TestLoadBalancerClient_EnsureLoadBalancer(t *testing.T) {
+	actions := []string{}
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		action := r.Form.Get("action")
+		switch action {
+		case "ModifyLoadBalancerListenerAttributes":
+			action += " " + r.Form.Get("loadbalancer_listener") + " " + r.Form.Get("balance_mode")
+		case "ModifyLoadBalancerBackendAttributes":
+			action += " " + r.Form.Get("loadbalancer_backend") + " " + r.Form.Get("weight")
+		case "DeleteLoadBalancerBackends":
+			action += " " + r.Form.Get("loadbalancer_backends.1")
+		case "DeleteLoadBalancerListeners":
+			action += " " + r.Form.Get("loadbalancer_listeners.1")
+		case "AddLoadBalancerBackends":
+			action += " " + r.Form.Get("loadbalancer_listener") + " " + r.Form.Get("backends.1.resource_id") +
+				" [" + r.Form.Get("backends.1.weight") + r.Form.Get("backends.1.loadbalancer_backend_name") + "]"
+		case "AddLoadBalancerListeners":
+			action += " " + r.Form.Get("listeners.1.listener_port")
+		}
+		actions = append(actions, action)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("action") {
+		case "DescribeLoadBalancers":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":1,"loadbalancer_set":[{"loadbalancer_id":"lb-1","status":"active","is_applied":1,"eips":[{"eip_id":"eip-1"}]}]}`)
+		case "DescribeLoadBalancerListeners":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":2,"loadbalancer_listener_set":[`+
+				`{"loadbalancer_listener_id":"lbl-80","listener_port":80,"listener_protocol":"http","backend_protocol":"http","balance_mode":"roundrobin"},`+
+				`{"loadbalancer_listener_id":"lbl-8080","listener_port":8080,"listener_protocol":"tcp","backend_protocol":"tcp"}]}`)
+		case "DescribeLoadBalancerBackends":
+			if r.Form.Get("offset") != "0" {
+				fmt.Fprint(w, `{"ret_code":0,"loadbalancer_backend_set":[]}`)
+				break
+			}
+			fmt.Fprint(w, `{"ret_code":0,"loadbalancer_backend_set":[`+
+				`{"loadbalancer_backend_id":"lbb-1","loadbalancer_listener_id":"lbl-80","resource_id":"i-1","port":80,"weight":5},`+
+				`{"loadbalancer_backend_id":"lbb-2","loadbalancer_listener_id":"lbl-80","resource_id":"i-2","port":80,"weight":5}]}`)
+		case "AddLoadBalancerListeners":
+			fmt.Fprint(w, `{"ret_code":0,"loadbalancer_listeners":["lbl-443"]}`)
+		case "DescribeJobs":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":1,"job_set":[{"job_id":"j-1","status":"successful"}]}`)
+		default:
+			fmt.Fprint(w, `{"ret_code":0,"job_id":"j-1"}`)
+		}
+	})
+	defer closeServer()
+
+	lbService, err := qcService.LoadBalancer("pek3a")
+	assert.Nil(t, err)
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	c := &loadBalancerClient{
+		LoadBalancerService: lbService,
+		JobService:          jobService,
+		OperationTimeout:    time.Minute,
+		WaitInterval:        10 * time.Millisecond,
+		jobWatcher:          NewJobWatcher(jobService, 10*time.Millisecond),
+	}
+
+	lb, err := c.EnsureLoadBalancer(&LoadBalancerSpec{
+		LoadBalancerID: "lb-1",
+		EIPs:           []string{"eip-1"},
+		Listeners: []*LoadBalancerListenerSpec{
+			{
+				ListenerPort:     80,
+				ListenerProtocol: "http",
+				BalanceMode:      "leastconn",
+				Backends: []*LoadBalancerBackendSpec{
+					{ResourceID: "i-1", Port: 80, Weight: 10},
+					{ResourceID: "i-2", Port: 80},
+					{ResourceID: "i-3", Port: 80},
+				},
+			},
+			{
+				ListenerPort:     443,
+				ListenerProtocol: "tcp",
+				Backends: []*LoadBalancerBackendSpec{
+					{ResourceID: "i-1", Port: 443, Weight: 5},
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "lb-1", *lb.LoadBalancerID)
+	assert.Equal(t, []string{
+		"DescribeLoadBalancers",
+		"DescribeLoadBalancerListeners",
+		"DescribeLoadBalancerBackends",
+		"ModifyLoadBalancerListenerAttributes lbl-80 leastconn",
+		"ModifyLoadBalancerBackendAttributes lbb-1 10",
+		"AddLoadBalancerBackends lbl-80 i-3 []",
+		"DeleteLoadBalancerListeners lbl-8080",
+		"AddLoadBalancerListeners 443",
+		"AddLoadBalancerBackends lbl-443 i-1 [5]",
+		"UpdateLoadBalancers",
+		"DescribeJobs",
+		"DescribeLoadBalancers",
+	}, actions)
+}
+
+func TestLoadBalancerClient_EnsureLoadBalancerUnchanged(t *testing.T) {
+	actions := []string{}
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		actions = append(actions, r.Form.Get("action"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("action") {
+		case "DescribeLoadBalancers":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":1,"loadbalancer_set":[{"loadbalancer_id":"lb-1","status":"active","is_applied":1,"eips":[{"eip_id":"eip-1"}]}]}`)
+		case "DescribeLoadBalancerListeners":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":1,"loadbalancer_listener_set":[`+
+				`{"loadbalancer_listener_id":"lbl-80","listener_port":80,"listener_protocol":"http","backend_protocol":"http","balance_mode":"roundrobin"}]}`)
+		case "DescribeLoadBalancerBackends":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":1,"loadbalancer_backend_set":[`+
+				`{"loadbalancer_backend_id":"lbb-1","loadbalancer_listener_id":"lbl-80","resource_id":"i-1","port":80,"weight":5}]}`)
+		default:
+			fmt.Fprint(w, `{"ret_code":0,"job_id":"j-1"}`)
+		}
+	})
+	defer closeServer()
+
+	lbService, err := qcService.LoadBalancer("pek3a")
+	assert.Nil(t, err)
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	c := &loadBalancerClient{
+		LoadBalancerService: lbService,
+		JobService:          jobService,
+		OperationTimeout:    time.Minute,
+		WaitInterval:        10 * time.Millisecond,
+		jobWatcher:          NewJobWatcher(jobService, 10*time.Millisecond),
+	}
+
+	spec := &LoadBalancerSpec{
+		LoadBalancerID: "lb-1",
+		EIPs:           []string{"eip-1"},
+		Listeners: []*LoadBalancerListenerSpec{
+			{
+				ListenerPort: 80,
+				Backends: []*LoadBalancerBackendSpec{
+					{ResourceID: "i-1", Port: 80},
+				},
+			},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		actions = actions[:0]
+		_, err = c.EnsureLoadBalancer(spec)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			"DescribeLoadBalancers",
+			"DescribeLoadBalancerListeners",
+			"DescribeLoadBalancerBackends",
+		}, actions)
+	}
+}
+
+func TestLoadBalancerClient_ResponseError(t *testing.T) {
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ret_code":0}`)
+	})
+	defer closeServer()
+
+	lbService, err := qcService.LoadBalancer("pek3a")
+	assert.Nil(t, err)
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	c := &loadBalancerClient{
+		LoadBalancerService: lbService,
+		JobService:          jobService,
+		OperationTimeout:    time.Minute,
+		WaitInterval:        10 * time.Millisecond,
+		jobWatcher:          NewJobWatcher(jobService, 10*time.Millisecond),
+	}
+
+	_, err = c.EnsureLoadBalancer(&LoadBalancerSpec{EIPs: []string{"eip-1"}})
+	assert.EqualError(t, err, "Create load balancer response error")
+	_, err = c.ensureEIPs(&service.LoadBalancer{LoadBalancerID: service.String("lb-1")}, []string{"eip-1"})
+	assert.Equal(t, errNoJobID, err)
+}
@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+const (
+	//DefaultMonitorMaxPoints max points of a series, the finest step keeping the series within it is used
+	DefaultMonitorMaxPoints = 1000
+	//DefaultMonitorMaxPointsPerRequest max points of a series returned by one GetMonitor call
+	DefaultMonitorMaxPointsPerRequest = 300
+	//DefaultMonitorConcurrency max GetMonitor calls running at the same time
+	DefaultMonitorConcurrency = 5
+)
+
+// monitorSteps steps accepted by GetMonitor, from the finest to the coarsest
+var monitorSteps = []string{"5m", "15m", "2h", "1d"}
+
+// MonitorSeries decoded monitor data of a meter of a resource
+type MonitorSeries struct {
+	ResourceID string
+	MeterID    string
+	// VxNetID is set for per vxnet meters such as traffic
+	VxNetID string
+	Step    string
+	Points  []service.Point
+}
+
+// MonitorQuery query of a monitor API call of a resource
+type MonitorQuery struct {
+	Resource  string
+	Meters    []string
+	StartTime time.Time
+	EndTime   time.Time
+	Step      string
+}
+
+// MonitorFunc call a monitor API, such as GetMonitor or GetRDBMonitor, and return the meters of the resource
+type MonitorFunc func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error)
+
+// NewGetMonitorFunc return a MonitorFunc calling GetMonitor, which serves instances, volumes, EIPs and other resources
+func NewGetMonitorFunc(s *service.MonitorService) MonitorFunc {
+	return func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		output, err := s.GetMonitorWithContext(ctx, &service.GetMonitorInput{
+			Resource:  service.String(query.Resource),
+			Meters:    service.StringSlice(query.Meters),
+			StartTime: service.Time(query.StartTime),
+			EndTime:   service.Time(query.EndTime),
+			Step:      service.String(query.Step),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.MeterSet, nil
+	}
+}
+
+// MonitorClient QingCloud monitor query client
+type MonitorClient interface {
+	GetMonitor(ctx context.Context, resources []string, meters []string, startTime, endTime time.Time) ([]*MonitorSeries, error)
+}
+
+// NewMonitorClient return a new MonitorClient
+func NewMonitorClient(config *config.Config, zone string) (MonitorClient, error) {
+	qcService, err := service.Init(config)
+	if err != nil {
+		return nil, err
+	}
+	monitorService, err := qcService.Monitor(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMonitorClientWithFunc(NewGetMonitorFunc(monitorService), DefaultMonitorMaxPoints,
+		DefaultMonitorMaxPointsPerRequest, DefaultMonitorConcurrency), nil
+}
+
+// NewMonitorClientWithFunc return a MonitorClient calling monitor for each chunk, such as a MonitorFunc
+// calling GetRDBMonitor. The defaults are used for the limits which are not positive
+func NewMonitorClientWithFunc(monitor MonitorFunc, maxPoints, maxPointsPerRequest, concurrency int) MonitorClient {
+	if maxPoints <= 0 {
+		maxPoints = DefaultMonitorMaxPoints
+	}
+	if maxPointsPerRequest <= 0 {
+		maxPointsPerRequest = DefaultMonitorMaxPointsPerRequest
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultMonitorConcurrency
+	}
+	return &monitorClient{
+		Monitor:             monitor,
+		MaxPoints:           maxPoints,
+		MaxPointsPerRequest: maxPointsPerRequest,
+		Concurrency:         concurrency,
+	}
+}
+
+type monitorClient struct {
+	Monitor             MonitorFunc
+	MaxPoints           int
+	MaxPointsPerRequest int
+	Concurrency         int
+}
+
+type monitorChunk struct {
+	resourceID string
+	startTime  time.Time
+	endTime    time.Time
+}
+
+type monitorSeriesKey struct {
+	resourceID string
+	meterID    string
+	vxnetID    string
+}
+
+// GetMonitor return the monitor data of the meters of the resources in the
+// time range. The finest step keeping the series within MaxPoints is used,
+// and the range is split into chunks of at most MaxPointsPerRequest points,
+// which are fetched concurrently and merged.
+func (c *monitorClient) GetMonitor(ctx context.Context, resources []string, meters []string, startTime, endTime time.Time) ([]*MonitorSeries, error) {
+	step, duration := c.selectStep(endTime.Sub(startTime))
+	maxPointsPerRequest := c.MaxPointsPerRequest
+	if maxPointsPerRequest <= 0 {
+		maxPointsPerRequest = DefaultMonitorMaxPointsPerRequest
+	}
+	chunkDuration := duration * time.Duration(maxPointsPerRequest)
+
+	chunks := []*monitorChunk{}
+	for _, resourceID := range resources {
+		for start := startTime; start.Before(endTime) || start.Equal(startTime); start = start.Add(chunkDuration) {
+			end := start.Add(chunkDuration)
+			if end.After(endTime) {
+				end = endTime
+			}
+			chunks = append(chunks, &monitorChunk{resourceID: resourceID, startTime: start, endTime: end})
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := c.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	semaphore := make(chan struct{}, concurrency)
+	mutex := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	var firstErr error
+	series := map[monitorSeriesKey]*MonitorSeries{}
+	keys := []monitorSeriesKey{}
+
+	for _, chunk := range chunks {
+		chunk := chunk
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-semaphore }()
+
+			chunkSeries, err := c.getMonitorChunk(ctx, chunk, meters, step, duration)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			for _, cs := range chunkSeries {
+				key := monitorSeriesKey{resourceID: cs.ResourceID, meterID: cs.MeterID, vxnetID: cs.VxNetID}
+				if s, ok := series[key]; ok {
+					s.Points = append(s.Points, cs.Points...)
+					continue
+				}
+				series[key] = cs
+				keys = append(keys, key)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].resourceID != keys[j].resourceID {
+			return keys[i].resourceID < keys[j].resourceID
+		}
+		if keys[i].meterID != keys[j].meterID {
+			return keys[i].meterID < keys[j].meterID
+		}
+		return keys[i].vxnetID < keys[j].vxnetID
+	})
+	result := make([]*MonitorSeries, 0, len(keys))
+	for _, key := range keys {
+		s := series[key]
+		s.Points = mergePoints(s.Points)
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func (c *monitorClient) getMonitorChunk(ctx context.Context, chunk *monitorChunk, meters []string,
+	step string, duration time.Duration) ([]*MonitorSeries, error) {
+	meterSet, err := c.Monitor(ctx, &MonitorQuery{
+		Resource:  chunk.resourceID,
+		Meters:    meters,
+		StartTime: chunk.startTime,
+		EndTime:   chunk.endTime,
+		Step:      step,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	series := []*MonitorSeries{}
+	for _, meter := range meterSet {
+		points, err := service.DecodeMeterData(meter.Data, chunk.startTime, duration)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, &MonitorSeries{
+			ResourceID: chunk.resourceID,
+			MeterID:    service.StringValue(meter.MeterID),
+			VxNetID:    service.StringValue(meter.VxNetID),
+			Step:       step,
+			Points:     points,
+		})
+	}
+	return series, nil
+}
+
+// selectStep return the finest step keeping the points of the range within MaxPoints
+func (c *monitorClient) selectStep(timeRange time.Duration) (string, time.Duration) {
+	for _, step := range monitorSteps {
+		duration, _ := service.MonitorStep(step)
+		if c.MaxPoints <= 0 || timeRange/duration <= time.Duration(c.MaxPoints) {
+			return step, duration
+		}
+	}
+	step := monitorSteps[len(monitorSteps)-1]
+	duration, _ := service.MonitorStep(step)
+	return step, duration
+}
+
+// mergePoints sort the points by time and drop the duplicated points on chunk boundaries
+func mergePoints(points []service.Point) []service.Point {
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+	merged := []service.Point{}
+	for _, point := range points {
+		if len(merged) > 0 && merged[len(merged)-1].Time.Equal(point.Time) {
+			continue
+		}
+		merged = append(merged, point)
+	}
+	return merged
+}
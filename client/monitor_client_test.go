@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+	"github.com/yunify/qingcloud-sdk-go/utils"
+)
+
+func TestMonitorClient_SelectStep(t *testing.T) {
+	c := &monitorClient{MaxPoints: DefaultMonitorMaxPoints}
+	testCases := map[time.Duration]string{
+		time.Hour:                 "5m",
+		24 * time.Hour:            "5m",
+		7 * 24 * time.Hour:        "15m",
+		30 * 24 * time.Hour:       "2h",
+		10 * 365 * 24 * time.Hour: "1d",
+	}
+	for timeRange, expected := range testCases {
+		step, _ := c.selectStep(timeRange)
+		assert.Equal(t, expected, step, timeRange.String())
+	}
+}
+
+func TestMonitorClient_GetMonitor(t *testing.T) {
+	mutex := &sync.Mutex{}
+	calls := []string{}
+	running, maxRunning := 0, 0
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		defer func() {
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+		}()
+
+		start, err := utils.StringToTime(r.Form.Get("start_time"), "ISO 8601")
+		assert.Nil(t, err)
+		end, err := utils.StringToTime(r.Form.Get("end_time"), "ISO 8601")
+		assert.Nil(t, err)
+		mutex.Lock()
+		calls = append(calls, fmt.Sprintf("%s %s %s-%s", r.Form.Get("resource"), r.Form.Get("step"),
+			start.Format("15:04"), end.Format("15:04")))
+		mutex.Unlock()
+
+		cpu := []string{fmt.Sprintf("[%d,1]", start.Unix())}
+		disk := []string{fmt.Sprintf("[%d,[1,2]]", start.Unix())}
+		for t := start.Add(5 * time.Minute); !t.After(end); t = t.Add(5 * time.Minute) {
+			cpu = append(cpu, "1")
+			disk = append(disk, "[1,2]")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"ret_code":0,"resource_id":"%s","meter_set":[`+
+			`{"meter_id":"cpu","data":[%s]},{"meter_id":"disk-os","data":[%s]}]}`,
+			r.Form.Get("resource"), strings.Join(cpu, ","), strings.Join(disk, ","))
+	})
+	defer closeServer()
+
+	monitorService, err := qcService.Monitor("pek3a")
+	assert.Nil(t, err)
+	c := &monitorClient{
+		Monitor:             NewGetMonitorFunc(monitorService),
+		MaxPoints:           20,
+		MaxPointsPerRequest: 5,
+		Concurrency:         2,
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	series, err := c.GetMonitor(context.Background(), []string{"i-2", "i-1"}, []string{"cpu", "disk-os"},
+		start, start.Add(time.Hour))
+	assert.Nil(t, err)
+	assert.Len(t, calls, 6)
+	assert.Contains(t, calls, "i-1 5m 00:00-00:25")
+	assert.Contains(t, calls, "i-1 5m 00:25-00:50")
+	assert.Contains(t, calls, "i-1 5m 00:50-01:00")
+	assert.True(t, maxRunning <= 2)
+
+	assert.Len(t, series, 4)
+	assert.Equal(t, "i-1", series[0].ResourceID)
+	assert.Equal(t, "cpu", series[0].MeterID)
+	assert.Equal(t, "disk-os", series[1].MeterID)
+	assert.Equal(t, "i-2", series[2].ResourceID)
+	for _, s := range series {
+		assert.Equal(t, "5m", s.Step)
+		assert.Len(t, s.Points, 13)
+		for i, point := range s.Points {
+			assert.True(t, start.Add(time.Duration(i)*5*time.Minute).Equal(point.Time))
+		}
+	}
+	assert.Equal(t, []service.Point{{Time: time.Unix(start.Unix(), 0), Values: []float64{1, 2}}}, series[1].Points[:1])
+}
+
+func TestMonitorClient_GetMonitorError(t *testing.T) {
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ret_code":1400,"message":"permission denied"}`)
+	})
+	defer closeServer()
+
+	monitorService, err := qcService.Monitor("pek3a")
+	assert.Nil(t, err)
+	c := &monitorClient{
+		Monitor:             NewGetMonitorFunc(monitorService),
+		MaxPoints:           DefaultMonitorMaxPoints,
+		MaxPointsPerRequest: DefaultMonitorMaxPointsPerRequest,
+		Concurrency:         DefaultMonitorConcurrency,
+	}
+
+	start := time.Now()
+	_, err = c.GetMonitor(context.Background(), []string{"i-1", "i-2"}, []string{"cpu"}, start.Add(-time.Hour), start)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+// RouterClient QingCloud router reconciliation client
+type RouterClient interface {
+	EnsurePortForwardings(routerID string, rules []*service.PortForwarding) error
+	GetOpenVPNProfile(routerID string, platform string) (*OpenVPNProfile, error)
+}
+
+// NewRouterClient return a new RouterClient
+func NewRouterClient(config *config.Config, zone string) (RouterClient, error) {
+	qcService, jobService, err := initServices(config, zone)
+	if err != nil {
+		return nil, err
+	}
+	routerService, err := qcService.Router(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &routerClient{
+		RouterService:    routerService,
+		JobService:       jobService,
+		OperationTimeout: defaultOpTimeout,
+		jobWatcher:       NewJobWatcher(jobService, defaultWaitInterval),
+		zone:             zone,
+	}
+	return c, nil
+}
+
+type routerClient struct {
+	RouterService    *service.RouterService
+	JobService       *service.JobService
+	OperationTimeout time.Duration
+	jobWatcher       *JobWatcher
+	zone             string
+}
+
+// portForwardingKey is the match part of a port forwarding, port forwardings
+// with the same key are modified in place instead of being recreated
+func portForwardingKey(static *service.RouterStatic) string {
+	protocol := service.StringValue(static.Val4)
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	return fmt.Sprintf("%s:%s", protocol, service.StringValue(static.Val1))
+}
+
+// EnsurePortForwardings make the port forwardings of the router the same as
+// the given rules and update the router. Rules are validated before any
+// request is sent.
+func (c *routerClient) EnsurePortForwardings(routerID string, rules []*service.PortForwarding) error {
+	desired := map[string]*service.RouterStatic{}
+	keys := []string{}
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		static := rule.RouterStatic()
+		key := portForwardingKey(static)
+		if _, ok := desired[key]; ok {
+			return fmt.Errorf("duplicate port forwarding of %s", key)
+		}
+		desired[key] = static
+		keys = append(keys, key)
+	}
+
+	current, err := c.RouterService.DescribeRouterStaticsAll(context.Background(), &service.DescribeRouterStaticsInput{
+		Router:     &routerID,
+		StaticType: service.Int(service.RouterStaticTypePortForwarding),
+	})
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]*service.RouterStatic{}
+	deleted := []*string{}
+	for _, static := range current {
+		key := portForwardingKey(static)
+		if _, ok := desired[key]; !ok {
+			deleted = append(deleted, static.RouterStaticID)
+			continue
+		}
+		existing[key] = static
+	}
+
+	changed := len(deleted) > 0
+	if len(deleted) > 0 {
+		_, err := c.RouterService.DeleteRouterStatics(&service.DeleteRouterStaticsInput{
+			RouterStatics: deleted,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	added := []*service.RouterStatic{}
+	for _, key := range keys {
+		static := desired[key]
+		old, ok := existing[key]
+		if !ok {
+			added = append(added, static)
+			continue
+		}
+		if service.StringValue(old.Val2) == service.StringValue(static.Val2) &&
+			service.StringValue(old.Val3) == service.StringValue(static.Val3) &&
+			(static.RouterStaticName == nil ||
+				service.StringValue(old.RouterStaticName) == service.StringValue(static.RouterStaticName)) {
+			continue
+		}
+		_, err := c.RouterService.ModifyRouterStaticAttributes(&service.ModifyRouterStaticAttributesInput{
+			RouterStatic:     old.RouterStaticID,
+			RouterStaticName: static.RouterStaticName,
+			Val2:             static.Val2,
+			Val3:             static.Val3,
+		})
+		if err != nil {
+			return err
+		}
+		changed = true
+	}
+
+	if len(added) > 0 {
+		_, err := c.RouterService.AddRouterStatics(&service.AddRouterStaticsInput{
+			Router:  &routerID,
+			Statics: added,
+		})
+		if err != nil {
+			return err
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	output, err := c.RouterService.UpdateRouters(&service.UpdateRoutersInput{
+		Routers: []*string{&routerID},
+	})
+	if err != nil {
+		return err
+	}
+	return waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+}
+
+// GetOpenVPNProfile return the client profile of the OpenVPN server of the router
+func (c *routerClient) GetOpenVPNProfile(routerID string, platform string) (*OpenVPNProfile, error) {
+	return NewOpenVPNProfile(c.RouterService, routerID, platform)
+}
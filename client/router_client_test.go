@@ -0,0 +1,122 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+func newTestRouterClient(t *testing.T, actions *[]string) (*routerClient, func()) {
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		action := r.Form.Get("action")
+		switch action {
+		case "DescribeRouterStatics":
+			action += " " + r.Form.Get("static_type")
+		case "DeleteRouterStatics":
+			action += " " + r.Form.Get("router_statics.1")
+		case "ModifyRouterStaticAttributes":
+			action += " " + r.Form.Get("router_static") + " " + r.Form.Get("val2") + " " + r.Form.Get("val3")
+		case "AddRouterStatics":
+			action += " " + r.Form.Get("statics.1.val1") + " " + r.Form.Get("statics.1.val4")
+		case "UpdateRouters":
+			action += " " + r.Form.Get("routers.1")
+		}
+		*actions = append(*actions, action)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("action") {
+		case "DescribeRouterStatics":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":3,"router_static_set":[`+
+				`{"router_static_id":"rtrs-ssh","static_type":1,"val1":"2222","val2":"192.168.0.2","val3":"22","val4":"tcp"},`+
+				`{"router_static_id":"rtrs-http","static_type":1,"val1":"80","val2":"192.168.0.3","val3":"80"},`+
+				`{"router_static_id":"rtrs-dns","static_type":1,"val1":"53","val2":"192.168.0.4","val3":"53","val4":"udp"}]}`)
+		case "UpdateRouters":
+			fmt.Fprint(w, `{"ret_code":0,"job_id":"j-1"}`)
+		case "DescribeJobs":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":1,"job_set":[{"job_id":"j-1","status":"successful"}]}`)
+		default:
+			fmt.Fprint(w, `{"ret_code":0}`)
+		}
+	})
+
+	routerService, err := qcService.Router("pek3a")
+	assert.Nil(t, err)
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	c := &routerClient{
+		RouterService:    routerService,
+		JobService:       jobService,
+		OperationTimeout: time.Minute,
+		jobWatcher:       NewJobWatcher(jobService, 10*time.Millisecond),
+	}
+	return c, closeServer
+}
+
+func TestRouterClient_EnsurePortForwardings(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestRouterClient(t, &actions)
+	defer closeServer()
+
+	err := c.EnsurePortForwardings("rtr-1", []*service.PortForwarding{
+		{SourcePort: 2222, PrivateIP: "192.168.0.2", PrivatePort: 22},
+		{SourcePort: 80, PrivateIP: "192.168.0.5", PrivatePort: 8080},
+		{SourcePort: 443, PrivateIP: "192.168.0.5", PrivatePort: 8443},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"DescribeRouterStatics 1",
+		"DeleteRouterStatics rtrs-dns",
+		"ModifyRouterStaticAttributes rtrs-http 192.168.0.5 8080",
+		"AddRouterStatics 443 tcp",
+		"UpdateRouters rtr-1",
+		"DescribeJobs",
+	}, actions)
+}
+
+func TestRouterClient_EnsurePortForwardingsUnchanged(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestRouterClient(t, &actions)
+	defer closeServer()
+
+	err := c.EnsurePortForwardings("rtr-1", []*service.PortForwarding{
+		{SourcePort: 2222, PrivateIP: "192.168.0.2", PrivatePort: 22},
+		{SourcePort: 80, PrivateIP: "192.168.0.3", PrivatePort: 80},
+		{SourcePort: 53, PrivateIP: "192.168.0.4", PrivatePort: 53, Protocol: "udp"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"DescribeRouterStatics 1"}, actions)
+}
+
+func TestRouterClient_EnsurePortForwardingsInvalid(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestRouterClient(t, &actions)
+	defer closeServer()
+
+	err := c.EnsurePortForwardings("rtr-1", []*service.PortForwarding{
+		{SourcePort: 80, PrivateIP: "192.168.0.5", PrivatePort: 8080},
+		{SourcePort: 80, PrivateIP: "192.168.0.6", PrivatePort: 8080},
+	})
+	assert.NotNil(t, err)
+
+	err = c.EnsurePortForwardings("rtr-1", []*service.PortForwarding{
+		{SourcePort: 80, PrivateIP: "localhost", PrivatePort: 8080},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{}, actions)
+}
+
+func TestRouterClient_WaitJobWithoutJobID(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestRouterClient(t, &actions)
+	defer closeServer()
+
+	assert.Equal(t, errNoJobID, waitJob(c.jobWatcher, nil, c.OperationTimeout))
+	assert.Equal(t, errNoJobID, waitJob(c.jobWatcher, service.String(""), c.OperationTimeout))
+	assert.Empty(t, actions)
+}
@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/logger"
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+const (
+	//SecurityGroupRuleActionAccept accept
+	SecurityGroupRuleActionAccept = "accept"
+	//SecurityGroupRuleActionDrop drop
+	SecurityGroupRuleActionDrop = "drop"
+	//SecurityGroupRuleDirectionIngress ingress
+	SecurityGroupRuleDirectionIngress = 0
+	//SecurityGroupRuleDirectionEgress egress
+	SecurityGroupRuleDirectionEgress = 1
+)
+
+// SecurityGroupClient QingCloud security group reconciliation client
+type SecurityGroupClient interface {
+	EnsureSecurityGroupRules(securityGroupID string, rules []*service.SecurityGroupRule) error
+}
+
+// NewSecurityGroupClient return a new SecurityGroupClient
+func NewSecurityGroupClient(config *config.Config, zone string) (SecurityGroupClient, error) {
+	qcService, jobService, err := initServices(config, zone)
+	if err != nil {
+		return nil, err
+	}
+	sgService, err := qcService.SecurityGroup(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &securityGroupClient{
+		SecurityGroupService: sgService,
+		JobService:           jobService,
+		OperationTimeout:     defaultOpTimeout,
+		jobWatcher:           NewJobWatcher(jobService, defaultWaitInterval),
+		zone:                 zone,
+	}
+	return c, nil
+}
+
+type securityGroupClient struct {
+	SecurityGroupService *service.SecurityGroupService
+	JobService           *service.JobService
+	OperationTimeout     time.Duration
+	jobWatcher           *JobWatcher
+	zone                 string
+}
+
+// securityGroupRuleKey is the match part of a rule, rules with the same key
+// are modified in place instead of being recreated
+type securityGroupRuleKey struct {
+	protocol  string
+	direction int
+	val1      string
+	val2      string
+	val3      string
+}
+
+func ruleKey(rule *service.SecurityGroupRule) securityGroupRuleKey {
+	return securityGroupRuleKey{
+		protocol:  service.StringValue(rule.Protocol),
+		direction: service.IntValue(rule.Direction),
+		val1:      service.StringValue(rule.Val1),
+		val2:      service.StringValue(rule.Val2),
+		val3:      service.StringValue(rule.Val3),
+	}
+}
+
+func ruleAction(rule *service.SecurityGroupRule) string {
+	if rule.Action == nil || *rule.Action == "" {
+		return SecurityGroupRuleActionAccept
+	}
+	return *rule.Action
+}
+
+// EnsureSecurityGroupRules make the rules of the security group the same as
+// the given rules and apply the security group. A snapshot of the security
+// group is taken before any change, and the security group is rolled back
+// to it if the change or applying fails. The snapshot is deleted after the
+// rules are applied. Rules are validated before any request is sent, and
+// rules with the same protocol, direction and values are not allowed.
+func (c *securityGroupClient) EnsureSecurityGroupRules(securityGroupID string, rules []*service.SecurityGroupRule) error {
+	desired := map[securityGroupRuleKey]int{}
+	for i, rule := range rules {
+		if err := service.ValidateSecurityGroupRule(rule); err != nil {
+			return err
+		}
+		key := ruleKey(rule)
+		if j, ok := desired[key]; ok {
+			return fmt.Errorf("Security group rule [%d] duplicates rule [%d]", i, j)
+		}
+		desired[key] = i
+	}
+
+	current, err := c.SecurityGroupService.DescribeSecurityGroupRulesAll(context.Background(), &service.DescribeSecurityGroupRulesInput{
+		SecurityGroup: &securityGroupID,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Duplicated rules of the security group are deleted except the first.
+	existing := map[securityGroupRuleKey]*service.SecurityGroupRule{}
+	deleted := []*string{}
+	for _, rule := range current {
+		key := ruleKey(rule)
+		_, isDesired := desired[key]
+		if _, ok := existing[key]; ok || !isDesired {
+			deleted = append(deleted, rule.SecurityGroupRuleID)
+			continue
+		}
+		existing[key] = rule
+	}
+
+	added := []*service.SecurityGroupRule{}
+	modified := []*service.ModifySecurityGroupRuleAttributesInput{}
+	for _, rule := range rules {
+		old, ok := existing[ruleKey(rule)]
+		if !ok {
+			added = append(added, rule)
+			continue
+		}
+		if ruleAction(old) != ruleAction(rule) || service.IntValue(old.Priority) != service.IntValue(rule.Priority) {
+			modified = append(modified, &service.ModifySecurityGroupRuleAttributesInput{
+				SecurityGroupRule: old.SecurityGroupRuleID,
+				RuleAction:        service.String(ruleAction(rule)),
+				Priority:          service.Int(service.IntValue(rule.Priority)),
+			})
+		}
+	}
+	if len(added) == 0 && len(modified) == 0 && len(deleted) == 0 {
+		return nil
+	}
+
+	snapshot, err := c.SecurityGroupService.CreateSecurityGroupSnapshot(&service.CreateSecurityGroupSnapshotInput{
+		SecurityGroup: &securityGroupID,
+	})
+	if err != nil {
+		return err
+	}
+	snapshotID := service.StringValue(snapshot.SecurityGroupSnapshotID)
+	if snapshotID == "" {
+		return errors.New("Create security group snapshot response error")
+	}
+
+	err = c.changeRules(securityGroupID, added, modified, deleted)
+	if err == nil {
+		err = c.applySecurityGroup(securityGroupID)
+	}
+	if err != nil {
+		rollbackErr := c.rollbackSecurityGroup(securityGroupID, snapshotID)
+		if rollbackErr != nil {
+			return fmt.Errorf("%w, and rollback to snapshot [%s] failed: %s",
+				err, snapshotID, rollbackErr.Error())
+		}
+		return err
+	}
+
+	_, err = c.SecurityGroupService.DeleteSecurityGroupSnapshots(&service.DeleteSecurityGroupSnapshotsInput{
+		SecurityGroupSnapshots: []*string{&snapshotID},
+	})
+	if err != nil {
+		logger.Warn("Delete security group snapshot [%s] error : [%s]", snapshotID, err.Error())
+	}
+	return nil
+}
+
+func (c *securityGroupClient) changeRules(securityGroupID string, added []*service.SecurityGroupRule,
+	modified []*service.ModifySecurityGroupRuleAttributesInput, deleted []*string) error {
+	if len(deleted) > 0 {
+		_, err := c.SecurityGroupService.DeleteSecurityGroupRules(&service.DeleteSecurityGroupRulesInput{
+			SecurityGroupRules: deleted,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, input := range modified {
+		_, err := c.SecurityGroupService.ModifySecurityGroupRuleAttributes(input)
+		if err != nil {
+			return err
+		}
+	}
+	if len(added) > 0 {
+		_, err := c.SecurityGroupService.AddSecurityGroupRules(&service.AddSecurityGroupRulesInput{
+			SecurityGroup: &securityGroupID,
+			Rules:         added,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *securityGroupClient) applySecurityGroup(securityGroupID string) error {
+	output, err := c.SecurityGroupService.ApplySecurityGroup(&service.ApplySecurityGroupInput{
+		SecurityGroup: &securityGroupID,
+	})
+	if err != nil {
+		return err
+	}
+	return waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+}
+
+func (c *securityGroupClient) rollbackSecurityGroup(securityGroupID string, snapshotID string) error {
+	_, err := c.SecurityGroupService.RollbackSecurityGroup(&service.RollbackSecurityGroupInput{
+		SecurityGroup:         &securityGroupID,
+		SecurityGroupSnapshot: &snapshotID,
+	})
+	if err != nil {
+		return err
+	}
+	return c.applySecurityGroup(securityGroupID)
+}
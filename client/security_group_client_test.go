@@ -0,0 +1,157 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+func newTestSecurityGroupClient(t *testing.T, snapshotID, jobStatus string, actions *[]string) (*securityGroupClient, func()) {
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		action := r.Form.Get("action")
+		switch action {
+		case "DeleteSecurityGroupRules":
+			action += " " + r.Form.Get("security_group_rules.1")
+		case "ModifySecurityGroupRuleAttributes":
+			action += " " + r.Form.Get("security_group_rule") + " " + r.Form.Get("rule_action") + " " + r.Form.Get("priority")
+		case "AddSecurityGroupRules":
+			action += " " + r.Form.Get("rules.1.protocol") + " " + r.Form.Get("rules.1.val1")
+		case "RollbackSecurityGroup", "DeleteSecurityGroupSnapshots":
+			action += " " + r.Form.Get("security_group_snapshot") + r.Form.Get("security_group_snapshots.1")
+		}
+		*actions = append(*actions, action)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("action") {
+		case "DescribeSecurityGroupRules":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":3,"security_group_rule_set":[`+
+				`{"security_group_rule_id":"sgr-ssh","protocol":"tcp","direction":0,"action":"accept","priority":1,"val1":"22","val2":"22"},`+
+				`{"security_group_rule_id":"sgr-http","protocol":"tcp","direction":0,"action":"accept","priority":1,"val1":"80","val2":"80"},`+
+				`{"security_group_rule_id":"sgr-icmp","protocol":"icmp","direction":0,"action":"accept","priority":1,"val1":"8","val2":"0"}]}`)
+		case "CreateSecurityGroupSnapshot":
+			fmt.Fprintf(w, `{"ret_code":0,"security_group_snapshot_id":"%s"}`, snapshotID)
+		case "ApplySecurityGroup":
+			fmt.Fprint(w, `{"ret_code":0,"job_id":"j-1"}`)
+		case "DescribeJobs":
+			fmt.Fprintf(w, `{"ret_code":0,"total_count":1,"job_set":[{"job_id":"j-1","status":"%s"}]}`, jobStatus)
+		default:
+			fmt.Fprint(w, `{"ret_code":0}`)
+		}
+	})
+
+	sgService, err := qcService.SecurityGroup("pek3a")
+	assert.Nil(t, err)
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	c := &securityGroupClient{
+		SecurityGroupService: sgService,
+		JobService:           jobService,
+		OperationTimeout:     time.Minute,
+		jobWatcher:           NewJobWatcher(jobService, 10*time.Millisecond),
+	}
+	return c, closeServer
+}
+
+var testSecurityGroupRules = []*service.SecurityGroupRule{
+	{Protocol: service.String("tcp"), Priority: service.Int(1), Val1: service.String("22"), Val2: service.String("22")},
+	{Protocol: service.String("tcp"), Priority: service.Int(2), Action: service.String("drop"),
+		Val1: service.String("80"), Val2: service.String("80")},
+	{Protocol: service.String("udp"), Priority: service.Int(1), Val1: service.String("53"), Val2: service.String("53")},
+}
+
+func TestSecurityGroupClient_EnsureSecurityGroupRules(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestSecurityGroupClient(t, "sgs-1", JobStatusSuccessful, &actions)
+	defer closeServer()
+
+	err := c.EnsureSecurityGroupRules("sg-1", testSecurityGroupRules)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"DescribeSecurityGroupRules",
+		"CreateSecurityGroupSnapshot",
+		"DeleteSecurityGroupRules sgr-icmp",
+		"ModifySecurityGroupRuleAttributes sgr-http drop 2",
+		"AddSecurityGroupRules udp 53",
+		"ApplySecurityGroup",
+		"DescribeJobs",
+		"DeleteSecurityGroupSnapshots sgs-1",
+	}, actions)
+}
+
+func TestSecurityGroupClient_EnsureSecurityGroupRulesUnchanged(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestSecurityGroupClient(t, "sgs-1", JobStatusSuccessful, &actions)
+	defer closeServer()
+
+	err := c.EnsureSecurityGroupRules("sg-1", []*service.SecurityGroupRule{
+		{Protocol: service.String("tcp"), Priority: service.Int(1), Val1: service.String("22"), Val2: service.String("22")},
+		{Protocol: service.String("tcp"), Priority: service.Int(1), Val1: service.String("80"), Val2: service.String("80")},
+		{Protocol: service.String("icmp"), Priority: service.Int(1), Val1: service.String("8"), Val2: service.String("0")},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"DescribeSecurityGroupRules"}, actions)
+}
+
+func TestSecurityGroupClient_EnsureSecurityGroupRulesRollback(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestSecurityGroupClient(t, "sgs-1", JobStatusFailed, &actions)
+	defer closeServer()
+
+	err := c.EnsureSecurityGroupRules("sg-1", testSecurityGroupRules)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "rollback to snapshot [sgs-1] failed")
+	assert.Equal(t, []string{
+		"DescribeSecurityGroupRules",
+		"CreateSecurityGroupSnapshot",
+		"DeleteSecurityGroupRules sgr-icmp",
+		"ModifySecurityGroupRuleAttributes sgr-http drop 2",
+		"AddSecurityGroupRules udp 53",
+		"ApplySecurityGroup",
+		"DescribeJobs",
+		"RollbackSecurityGroup sgs-1",
+		"ApplySecurityGroup",
+		"DescribeJobs",
+	}, actions)
+}
+
+func TestSecurityGroupClient_EnsureSecurityGroupRulesInvalid(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestSecurityGroupClient(t, "sgs-1", JobStatusSuccessful, &actions)
+	defer closeServer()
+
+	err := c.EnsureSecurityGroupRules("sg-1", []*service.SecurityGroupRule{
+		service.TCPRule(service.Ports(8080, 80), ""),
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{}, actions)
+}
+
+func TestSecurityGroupClient_EnsureSecurityGroupRulesDuplicated(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestSecurityGroupClient(t, "sgs-1", JobStatusSuccessful, &actions)
+	defer closeServer()
+
+	err := c.EnsureSecurityGroupRules("sg-1", []*service.SecurityGroupRule{
+		service.TCPRule(service.Port(443), ""),
+		service.TCPRule(service.Port(22), ""),
+		service.TCPRule(service.Port(443), ""),
+	})
+	assert.EqualError(t, err, "Security group rule [2] duplicates rule [0]")
+	assert.Equal(t, []string{}, actions)
+}
+
+func TestSecurityGroupClient_EnsureSecurityGroupRulesNoSnapshot(t *testing.T) {
+	actions := []string{}
+	c, closeServer := newTestSecurityGroupClient(t, "", JobStatusSuccessful, &actions)
+	defer closeServer()
+
+	err := c.EnsureSecurityGroupRules("sg-1", testSecurityGroupRules)
+	assert.EqualError(t, err, "Create security group snapshot response error")
+	assert.Equal(t, []string{"DescribeSecurityGroupRules", "CreateSecurityGroupSnapshot"}, actions)
+}
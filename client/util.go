@@ -1,19 +1,53 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/yunify/qingcloud-sdk-go/config"
 	"github.com/yunify/qingcloud-sdk-go/logger"
 	"github.com/yunify/qingcloud-sdk-go/service"
 	"github.com/yunify/qingcloud-sdk-go/utils"
 	"time"
 )
 
+// errNoJobID returned when a response of a job API has no job id
+var errNoJobID = errors.New("Response error: no job id")
+
+// initServices init the QingCloudService with config and the JobService of zone
+func initServices(config *config.Config, zone string) (*service.QingCloudService, *service.JobService, error) {
+	qcService, err := service.Init(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	jobService, err := qcService.Job(zone)
+	if err != nil {
+		return nil, nil, err
+	}
+	return qcService, jobService, nil
+}
+
+// waitJob wait the job with this jobID finish with the watcher, it return
+// errNoJobID if jobID is empty, and a *utils.TimeoutError if the job is not
+// finished in timeout
+func waitJob(watcher *JobWatcher, jobID *string, timeout time.Duration) error {
+	if service.StringValue(jobID) == "" {
+		return errNoJobID
+	}
+	return watcher.WaitWithTimeout(*jobID, timeout)
+}
+
 // WaitJob wait the job with this jobID finish
 func WaitJob(jobService *service.JobService, jobID string, timeout time.Duration, waitInterval time.Duration) error {
+	return WaitJobWithContext(context.Background(), jobService, jobID, timeout, waitInterval)
+}
+
+// WaitJobWithContext wait the job with this jobID finish or the context done
+func WaitJobWithContext(ctx context.Context, jobService *service.JobService, jobID string, timeout time.Duration, waitInterval time.Duration) error {
 	logger.Debug("Waiting for Job [%s] finished", jobID)
-	return utils.WaitForSpecificOrError(func() (bool, error) {
+	return utils.WaitForSpecificOrErrorWithContext(ctx, func() (bool, error) {
 		input := &service.DescribeJobsInput{Jobs: []*string{&jobID}}
-		output, err := jobService.DescribeJobs(input)
+		output, err := jobService.DescribeJobsWithContext(ctx, input)
 		if err != nil {
 			//network or api error, not considered job fail.
 			return false, nil
@@ -32,8 +66,8 @@ func WaitJob(jobService *service.JobService, jobID string, timeout time.Duration
 		if *j.Status == "successful" {
 			return true, nil
 		}
-		if *j.Status == "failed" {
-			return false, fmt.Errorf("Job [%s] failed", jobID)
+		if *j.Status == JobStatusFailed || *j.Status == JobStatusDoneWithFailure {
+			return false, &JobError{JobID: jobID, Status: *j.Status}
 		}
 		logger.Error("Unknow status [%s] for job [%s]", *j.Status, jobID)
 		return false, nil
@@ -58,9 +92,9 @@ func CheckJobStatus(jobService *service.JobService, jobID string) (string, error
 	return *j.Status, nil
 }
 
-func describeInstance(instanceService *service.InstanceService, instanceID string) (*service.Instance, error) {
+func describeInstance(ctx context.Context, instanceService *service.InstanceService, instanceID string) (*service.Instance, error) {
 	input := &service.DescribeInstancesInput{Instances: []*string{&instanceID}}
-	output, err := instanceService.DescribeInstances(input)
+	output, err := instanceService.DescribeInstancesWithContext(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -72,10 +106,15 @@ func describeInstance(instanceService *service.InstanceService, instanceID strin
 
 // WaitInstanceStatus wait the instance with this instanceID to expect status
 func WaitInstanceStatus(instanceService *service.InstanceService, instanceID string, status string, timeout time.Duration, waitInterval time.Duration) (ins *service.Instance, err error) {
+	return WaitInstanceStatusWithContext(context.Background(), instanceService, instanceID, status, timeout, waitInterval)
+}
+
+// WaitInstanceStatusWithContext wait the instance with this instanceID to expect status or the context done
+func WaitInstanceStatusWithContext(ctx context.Context, instanceService *service.InstanceService, instanceID string, status string, timeout time.Duration, waitInterval time.Duration) (ins *service.Instance, err error) {
 	logger.Debug("Waiting for Instance [%s] status [%s] ", instanceID, status)
 	errorTimes := 0
-	err = utils.WaitForSpecificOrError(func() (bool, error) {
-		i, err := describeInstance(instanceService, instanceID)
+	err = utils.WaitForSpecificOrErrorWithContext(ctx, func() (bool, error) {
+		i, err := describeInstance(ctx, instanceService, instanceID)
 		if err != nil {
 			logger.Error("DescribeInstance [%s] error : [%s]", instanceID, err.Error())
 			errorTimes++
@@ -100,9 +139,14 @@ func WaitInstanceStatus(instanceService *service.InstanceService, instanceID str
 
 // WaitInstanceNetwork wait the instance with this instanceID network become ready
 func WaitInstanceNetwork(instanceService *service.InstanceService, instanceID string, timeout time.Duration, waitInterval time.Duration) (ins *service.Instance, err error) {
+	return WaitInstanceNetworkWithContext(context.Background(), instanceService, instanceID, timeout, waitInterval)
+}
+
+// WaitInstanceNetworkWithContext wait the instance with this instanceID network become ready or the context done
+func WaitInstanceNetworkWithContext(ctx context.Context, instanceService *service.InstanceService, instanceID string, timeout time.Duration, waitInterval time.Duration) (ins *service.Instance, err error) {
 	logger.Debug("Waiting for IP address to be assigned to Instance [%s]", instanceID)
-	err = utils.WaitForSpecificOrError(func() (bool, error) {
-		i, err := describeInstance(instanceService, instanceID)
+	err = utils.WaitForSpecificOrErrorWithContext(ctx, func() (bool, error) {
+		i, err := describeInstance(ctx, instanceService, instanceID)
 		if err != nil {
 			return false, err
 		}
@@ -116,8 +160,8 @@ func WaitInstanceNetwork(instanceService *service.InstanceService, instanceID st
 	return
 }
 
-func describeLoadBalancer(lbService *service.LoadBalancerService, loadBalancerID string) (*service.LoadBalancer, error) {
-	output, err := lbService.DescribeLoadBalancers(&service.DescribeLoadBalancersInput{
+func describeLoadBalancer(ctx context.Context, lbService *service.LoadBalancerService, loadBalancerID string) (*service.LoadBalancer, error) {
+	output, err := lbService.DescribeLoadBalancersWithContext(ctx, &service.DescribeLoadBalancersInput{
 		LoadBalancers: []*string{&loadBalancerID},
 	})
 	if err != nil {
@@ -132,10 +176,15 @@ func describeLoadBalancer(lbService *service.LoadBalancerService, loadBalancerID
 
 // WaitLoadBalancerStatus wait the loadBalancer with this loadBalancerID to expect status
 func WaitLoadBalancerStatus(lbService *service.LoadBalancerService, loadBalancerID string, status string, timeout time.Duration, waitInterval time.Duration) (lb *service.LoadBalancer, err error) {
+	return WaitLoadBalancerStatusWithContext(context.Background(), lbService, loadBalancerID, status, timeout, waitInterval)
+}
+
+// WaitLoadBalancerStatusWithContext wait the loadBalancer with this loadBalancerID to expect status or the context done
+func WaitLoadBalancerStatusWithContext(ctx context.Context, lbService *service.LoadBalancerService, loadBalancerID string, status string, timeout time.Duration, waitInterval time.Duration) (lb *service.LoadBalancer, err error) {
 	logger.Debug("Waiting for LoadBalancer [%s] status [%s] ", loadBalancerID, status)
 	errorTimes := 0
-	err = utils.WaitForSpecificOrError(func() (bool, error) {
-		i, err := describeLoadBalancer(lbService, loadBalancerID)
+	err = utils.WaitForSpecificOrErrorWithContext(ctx, func() (bool, error) {
+		i, err := describeLoadBalancer(ctx, lbService, loadBalancerID)
 		if err != nil {
 			logger.Error("DescribeLoadBalancer [%s] error : [%s]", loadBalancerID, err.Error())
 			errorTimes++
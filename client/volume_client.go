@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/request"
+	"github.com/yunify/qingcloud-sdk-go/service"
+	"github.com/yunify/qingcloud-sdk-go/utils"
+)
+
+const (
+	//VolumeStatusPending pending
+	VolumeStatusPending = "pending"
+	//VolumeStatusAvailable available
+	VolumeStatusAvailable = "available"
+	//VolumeStatusInUse in-use
+	VolumeStatusInUse = "in-use"
+	//VolumeStatusSuspended suspended
+	VolumeStatusSuspended = "suspended"
+	//VolumeStatusDeleted deleted
+	VolumeStatusDeleted = "deleted"
+	//VolumeStatusCeased ceased
+	VolumeStatusCeased = "ceased"
+)
+
+// VolumeClient QingCloud volume lifecycle client
+type VolumeClient interface {
+	CreateVolume(arg *service.CreateVolumesInput) (*service.Volume, error)
+	DescribeVolume(volumeID string) (*service.Volume, error)
+	AttachVolume(volumeID string, instanceID string) error
+	DetachVolume(volumeID string, instanceID string) error
+	ResizeVolume(volumeID string, size int) error
+	DeleteVolume(volumeID string) error
+	WaitVolumeStatus(volumeID string, status string) (*service.Volume, error)
+	WaitVolumeStable(volumeID string) (*service.Volume, error)
+}
+
+// NewVolumeClient return a new VolumeClient
+func NewVolumeClient(config *config.Config, zone string) (VolumeClient, error) {
+	qcService, jobService, err := initServices(config, zone)
+	if err != nil {
+		return nil, err
+	}
+	volumeService, err := qcService.Volume(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &volumeClient{
+		VolumeService:    volumeService,
+		JobService:       jobService,
+		OperationTimeout: defaultOpTimeout,
+		WaitInterval:     defaultWaitInterval,
+		jobWatcher:       NewJobWatcher(jobService, defaultWaitInterval),
+		zone:             zone,
+	}
+	return c, nil
+}
+
+type volumeClient struct {
+	VolumeService    *service.VolumeService
+	JobService       *service.JobService
+	OperationTimeout time.Duration
+	WaitInterval     time.Duration
+	jobWatcher       *JobWatcher
+	zone             string
+}
+
+// CreateVolume create a volume and wait it available
+func (c *volumeClient) CreateVolume(input *service.CreateVolumesInput) (*service.Volume, error) {
+	output, err := c.VolumeService.CreateVolumes(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Volumes) == 0 || service.StringValue(output.Volumes[0]) == "" {
+		return nil, errors.New("Create volume response error")
+	}
+	jobErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+	if jobErr != nil {
+		return nil, jobErr
+	}
+	return c.WaitVolumeStatus(*output.Volumes[0], VolumeStatusAvailable)
+}
+
+// DescribeVolume
+func (c *volumeClient) DescribeVolume(volumeID string) (*service.Volume, error) {
+	input := &service.DescribeVolumesInput{Volumes: []*string{&volumeID}}
+	output, err := c.VolumeService.DescribeVolumes(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.VolumeSet) == 0 {
+		return nil, fmt.Errorf("Volume with id [%s] not exist", volumeID)
+	}
+	return output.VolumeSet[0], nil
+}
+
+// AttachVolume attach the volume to the instance and wait it in use
+func (c *volumeClient) AttachVolume(volumeID string, instanceID string) error {
+	input := &service.AttachVolumesInput{Volumes: []*string{&volumeID}, Instance: &instanceID}
+	output, err := c.VolumeService.AttachVolumes(input)
+	if err != nil {
+		return err
+	}
+	waitErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+	if waitErr != nil {
+		return waitErr
+	}
+	_, err = c.WaitVolumeStatus(volumeID, VolumeStatusInUse)
+	return err
+}
+
+// DetachVolume detach the volume from the instance and wait it available
+func (c *volumeClient) DetachVolume(volumeID string, instanceID string) error {
+	input := &service.DetachVolumesInput{Volumes: []*string{&volumeID}, Instance: &instanceID}
+	output, err := c.VolumeService.DetachVolumes(input)
+	if err != nil {
+		return err
+	}
+	waitErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+	if waitErr != nil {
+		return waitErr
+	}
+	_, err = c.WaitVolumeStatus(volumeID, VolumeStatusAvailable)
+	return err
+}
+
+// ResizeVolume resize the volume, a volume in use is detached before resizing
+// and attached to the same instance again after resizing
+func (c *volumeClient) ResizeVolume(volumeID string, size int) error {
+	volume, err := c.WaitVolumeStable(volumeID)
+	if err != nil {
+		return err
+	}
+
+	instanceID := ""
+	if service.StringValue(volume.Status) == VolumeStatusInUse {
+		if volume.Instance == nil || volume.Instance.InstanceID == nil {
+			return fmt.Errorf("Can not find the instance of volume [%s]", volumeID)
+		}
+		instanceID = *volume.Instance.InstanceID
+		err = c.DetachVolume(volumeID, instanceID)
+		if err != nil {
+			return err
+		}
+	}
+
+	resizeErr := c.resizeVolume(volumeID, size)
+	if instanceID != "" {
+		err = c.AttachVolume(volumeID, instanceID)
+		if err != nil && resizeErr == nil {
+			return err
+		}
+	}
+	return resizeErr
+}
+
+func (c *volumeClient) resizeVolume(volumeID string, size int) error {
+	input := &service.ResizeVolumesInput{Volumes: []*string{&volumeID}, Size: &size}
+	output, err := c.VolumeService.ResizeVolumes(input)
+	if err != nil {
+		return err
+	}
+	waitErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+	if waitErr != nil {
+		return waitErr
+	}
+	_, err = c.WaitVolumeStatus(volumeID, VolumeStatusAvailable)
+	return err
+}
+
+// DeleteVolume delete the volume and wait it deleted
+func (c *volumeClient) DeleteVolume(volumeID string) error {
+	input := &service.DeleteVolumesInput{Volumes: []*string{&volumeID}}
+	output, err := c.VolumeService.DeleteVolumes(input)
+	if err != nil {
+		return err
+	}
+	waitErr := waitJob(c.jobWatcher, output.JobID, c.OperationTimeout)
+	if waitErr != nil {
+		return waitErr
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.OperationTimeout)
+	defer cancel()
+	w := c.volumeWaiter(volumeID,
+		request.WaiterAcceptor{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: VolumeStatusDeleted},
+		request.WaiterAcceptor{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: VolumeStatusCeased},
+		request.WaiterAcceptor{State: request.SuccessWaiterState, Matcher: request.MissingWaiterMatch},
+	)
+	_, err = w.WaitWithContext(ctx)
+	return c.timeoutError(err)
+}
+
+// WaitVolumeStatus wait the volume in the status with empty transition status
+func (c *volumeClient) WaitVolumeStatus(volumeID string, status string) (*service.Volume, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.OperationTimeout)
+	defer cancel()
+	volumes, err := c.VolumeService.WaitUntilVolumesStatus(ctx, []*string{&volumeID}, status,
+		request.WithWaiterDelay(time.Second, c.WaitInterval))
+	if err != nil {
+		return nil, c.timeoutError(err)
+	}
+	return volumes[0], nil
+}
+
+// WaitVolumeStable wait the volume available or in use with empty transition status
+func (c *volumeClient) WaitVolumeStable(volumeID string) (*service.Volume, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.OperationTimeout)
+	defer cancel()
+	w := c.volumeWaiter(volumeID,
+		request.WaiterAcceptor{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: VolumeStatusAvailable},
+		request.WaiterAcceptor{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: VolumeStatusInUse},
+		request.WaiterAcceptor{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+	)
+	output, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, c.timeoutError(err)
+	}
+	return output.(*service.DescribeVolumesOutput).VolumeSet[0], nil
+}
+
+func (c *volumeClient) volumeWaiter(volumeID string, acceptors ...request.WaiterAcceptor) *request.Waiter {
+	return &request.Waiter{
+		Name: "WaitVolume",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			return c.VolumeService.DescribeVolumesWithContext(ctx, &service.DescribeVolumesInput{
+				Volumes: []*string{&volumeID},
+			})
+		},
+		StatusPath:           "VolumeSet[].Status",
+		TransitionStatusPath: "VolumeSet[].TransitionStatus",
+		Count:                1,
+		Acceptors:            acceptors,
+		MinDelay:             time.Second,
+		MaxDelay:             c.WaitInterval,
+	}
+}
+
+// timeoutError return a *utils.TimeoutError if the volume is not in the
+// expected status in OperationTimeout, like waitJob
+func (c *volumeClient) timeoutError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return utils.NewTimeoutError(c.OperationTimeout)
+	}
+	return err
+}
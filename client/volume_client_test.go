@@ -0,0 +1,123 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+	"github.com/yunify/qingcloud-sdk-go/utils"
+)
+
+func TestVolumeClient_ResizeVolume(t *testing.T) {
+	status, size := VolumeStatusInUse, 10
+	actions := []string{}
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		action := r.Form.Get("action")
+		actions = append(actions, action)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch action {
+		case "DescribeVolumes":
+			fmt.Fprintf(w, `{"ret_code":0,"total_count":1,"volume_set":[{"volume_id":"vol-1","status":"%s","size":%d,"instance":{"instance_id":"i-1"}}]}`, status, size)
+		case "DescribeJobs":
+			fmt.Fprintf(w, `{"ret_code":0,"total_count":1,"job_set":[{"job_id":"j-1","status":"successful"}]}`)
+		case "DetachVolumes":
+			status = VolumeStatusAvailable
+			fmt.Fprintf(w, `{"ret_code":0,"job_id":"j-1"}`)
+		case "ResizeVolumes":
+			size = 20
+			fmt.Fprintf(w, `{"ret_code":0,"job_id":"j-1"}`)
+		case "AttachVolumes":
+			status = VolumeStatusInUse
+			fmt.Fprintf(w, `{"ret_code":0,"job_id":"j-1"}`)
+		}
+	})
+	defer closeServer()
+
+	volumeService, err := qcService.Volume("pek3a")
+	assert.Nil(t, err)
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	c := &volumeClient{
+		VolumeService:    volumeService,
+		JobService:       jobService,
+		OperationTimeout: time.Minute,
+		WaitInterval:     10 * time.Millisecond,
+		jobWatcher:       NewJobWatcher(jobService, 10*time.Millisecond),
+	}
+
+	assert.Nil(t, c.ResizeVolume("vol-1", 20))
+	assert.Equal(t, []string{
+		"DescribeVolumes",
+		"DetachVolumes", "DescribeJobs", "DescribeVolumes",
+		"ResizeVolumes", "DescribeJobs", "DescribeVolumes",
+		"AttachVolumes", "DescribeJobs", "DescribeVolumes",
+	}, actions)
+	assert.Equal(t, 20, size)
+	assert.Equal(t, VolumeStatusInUse, status)
+}
+
+func TestVolumeClient_ResponseError(t *testing.T) {
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ret_code":0}`)
+	})
+	defer closeServer()
+
+	volumeService, err := qcService.Volume("pek3a")
+	assert.Nil(t, err)
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	c := &volumeClient{
+		VolumeService:    volumeService,
+		JobService:       jobService,
+		OperationTimeout: time.Minute,
+		WaitInterval:     10 * time.Millisecond,
+		jobWatcher:       NewJobWatcher(jobService, 10*time.Millisecond),
+	}
+
+	_, err = c.CreateVolume(&service.CreateVolumesInput{Size: service.Int(10)})
+	assert.EqualError(t, err, "Create volume response error")
+	assert.Equal(t, errNoJobID, c.AttachVolume("vol-1", "i-1"))
+	assert.Equal(t, errNoJobID, c.DeleteVolume("vol-1"))
+}
+
+func TestVolumeClient_WaitTimeout(t *testing.T) {
+	qcService, closeServer := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("action") {
+		case "DescribeVolumes":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":1,"volume_set":[{"volume_id":"vol-1","status":"pending","transition_status":"creating"}]}`)
+		case "DeleteVolumes":
+			fmt.Fprint(w, `{"ret_code":0,"job_id":"j-1"}`)
+		case "DescribeJobs":
+			fmt.Fprint(w, `{"ret_code":0,"total_count":1,"job_set":[{"job_id":"j-1","status":"successful"}]}`)
+		}
+	})
+	defer closeServer()
+
+	volumeService, err := qcService.Volume("pek3a")
+	assert.Nil(t, err)
+	jobService, err := qcService.Job("pek3a")
+	assert.Nil(t, err)
+	c := &volumeClient{
+		VolumeService:    volumeService,
+		JobService:       jobService,
+		OperationTimeout: 50 * time.Millisecond,
+		WaitInterval:     10 * time.Millisecond,
+		jobWatcher:       NewJobWatcher(jobService, 10*time.Millisecond),
+	}
+
+	_, err = c.WaitVolumeStatus("vol-1", VolumeStatusAvailable)
+	assert.IsType(t, &utils.TimeoutError{}, err)
+	_, err = c.WaitVolumeStable("vol-1")
+	assert.IsType(t, &utils.TimeoutError{}, err)
+	err = c.DeleteVolume("vol-1")
+	assert.IsType(t, &utils.TimeoutError{}, err)
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+const (
+	//VPNPlatformLinux linux
+	VPNPlatformLinux = "linux"
+	//VPNPlatformMac mac
+	VPNPlatformMac = "mac"
+	//VPNPlatformWindows windows
+	VPNPlatformWindows = "windows"
+)
+
+// OpenVPNProfile client profile of the OpenVPN server running on a router
+type OpenVPNProfile struct {
+	RemoteHost string
+	RemotePort int
+	Protocol   string
+	CACert     string
+	ClientCert string
+	ClientKey  string
+	// StaticKey is the tls-auth key, it's omitted if empty
+	StaticKey string
+	// Platform decides the line endings of the profile, windows profiles
+	// use CRLF and others use LF
+	Platform string
+}
+
+// NewOpenVPNProfile return the client profile of the OpenVPN server of the
+// router, which is reached through the EIP of the router. The platform is
+// VPNPlatformLinux if it's empty
+func NewOpenVPNProfile(routerService *service.RouterService, routerID string, platform string) (*OpenVPNProfile, error) {
+	if platform == "" {
+		platform = VPNPlatformLinux
+	}
+	routers, err := routerService.DescribeRouters(&service.DescribeRoutersInput{
+		Routers: []*string{&routerID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(routers.RouterSet) == 0 {
+		return nil, fmt.Errorf("router [%s] not found", routerID)
+	}
+	router := routers.RouterSet[0]
+	if router.EIP == nil || service.StringValue(router.EIP.EIPAddr) == "" {
+		return nil, fmt.Errorf("router [%s] has no EIP", routerID)
+	}
+
+	statics, err := routerService.DescribeRouterStatics(&service.DescribeRouterStaticsInput{
+		Router:     &routerID,
+		StaticType: service.Int(service.RouterStaticTypeVPN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var vpn *service.OpenVPN
+	for _, static := range statics.RouterStaticSet {
+		if service.StringValue(static.Val1) != service.RouterVPNTypeOpenVPN {
+			continue
+		}
+		typed, err := service.ParseRouterStatic(static)
+		if err != nil {
+			return nil, err
+		}
+		vpn = typed.(*service.OpenVPN)
+		break
+	}
+	if vpn == nil {
+		return nil, fmt.Errorf("router [%s] has no OpenVPN server", routerID)
+	}
+
+	certs, err := routerService.GetVPNCerts(&service.GetVPNCertsInput{
+		Router:   &routerID,
+		Platform: &platform,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenVPNProfile{
+		RemoteHost: *router.EIP.EIPAddr,
+		RemotePort: vpn.Port,
+		Protocol:   vpn.Protocol,
+		CACert:     service.StringValue(certs.CaCert),
+		ClientCert: service.StringValue(certs.ClientCrt),
+		ClientKey:  service.StringValue(certs.ClientKey),
+		StaticKey:  service.StringValue(certs.StaticKey),
+		Platform:   platform,
+	}, nil
+}
+
+// Render return the content of the .ovpn profile with inline certs and keys
+func (p *OpenVPNProfile) Render() []byte {
+	port := p.RemotePort
+	if port == 0 {
+		port = 1194
+	}
+	protocol := p.Protocol
+	if protocol == "" {
+		protocol = "udp"
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "client")
+	fmt.Fprintln(buf, "dev tun")
+	fmt.Fprintf(buf, "proto %s\n", protocol)
+	fmt.Fprintf(buf, "remote %s %d\n", p.RemoteHost, port)
+	fmt.Fprintln(buf, "resolv-retry infinite")
+	fmt.Fprintln(buf, "nobind")
+	fmt.Fprintln(buf, "persist-key")
+	fmt.Fprintln(buf, "persist-tun")
+	fmt.Fprintln(buf, "remote-cert-tls server")
+	fmt.Fprintln(buf, "verb 3")
+	if p.StaticKey != "" {
+		fmt.Fprintln(buf, "key-direction 1")
+	}
+	writeInlineBlock(buf, "ca", p.CACert)
+	writeInlineBlock(buf, "cert", p.ClientCert)
+	writeInlineBlock(buf, "key", p.ClientKey)
+	if p.StaticKey != "" {
+		writeInlineBlock(buf, "tls-auth", p.StaticKey)
+	}
+
+	if p.Platform == VPNPlatformWindows {
+		return bytes.Replace(buf.Bytes(), []byte("\n"), []byte("\r\n"), -1)
+	}
+	return buf.Bytes()
+}
+
+func writeInlineBlock(buf *bytes.Buffer, tag string, content string) {
+	content = strings.Replace(content, "\r\n", "\n", -1)
+	fmt.Fprintf(buf, "<%s>\n%s\n</%s>\n", tag, strings.TrimSpace(content), tag)
+}
@@ -25,8 +25,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
-	"time"
 
+	"github.com/yunify/qingcloud-sdk-go/credentials"
 	"github.com/yunify/qingcloud-sdk-go/logger"
 	"github.com/yunify/qingcloud-sdk-go/utils"
 )
@@ -43,21 +43,84 @@ type Config struct {
 	ConnectionRetries int    `yaml:"connection_retries"`
 	ConnectionTimeout int    `yaml:"connection_timeout"`
 
+	// RetryableRetCodes overrides the ret codes retried by the default retryer.
+	RetryableRetCodes []int `yaml:"retryable_ret_codes"`
+	// Retryer decides how failed requests are retried, a DefaultRetryer
+	// using ConnectionRetries is used if it's nil.
+	Retryer Retryer `yaml:"-"`
+
+	// RateLimit is the number of API requests allowed per second, with
+	// bursts of RateBurst requests. APIRateLimits overrides it for the given
+	// API names, such as RunInstances. No rate limit is applied by default.
+	RateLimit     float64            `yaml:"rate_limit"`
+	RateBurst     int                `yaml:"rate_burst"`
+	APIRateLimits map[string]float64 `yaml:"api_rate_limits"`
+	// RateLimiter limits the rate of API requests, a shared
+	// DefaultRateLimiter is used if it's nil, see GetRateLimiter.
+	RateLimiter RateLimiter `yaml:"-"`
+
+	// Handlers are request handlers run by every request made with this
+	// Config, after the handlers of the service. It's a *request.Handlers,
+	// which is not referenced here to avoid an import cycle, see
+	// request.ConfigHandlers.
+	Handlers interface{} `yaml:"-"`
+
 	LogLevel string `yaml:"log_level"`
 
+	// ProxyURL is the proxy for API requests, HTTP_PROXY, HTTPS_PROXY and
+	// NO_PROXY environment variables are used if it's empty.
+	ProxyURL string `yaml:"proxy_url"`
+	// CAFile is the PEM file of CA certificates to verify the API server,
+	// the system CA certificates are used if it's empty.
+	CAFile string `yaml:"ca_file"`
+	// ClientCertFile and ClientKeyFile are the PEM files of the client
+	// certificate for mutual TLS.
+	ClientCertFile string `yaml:"client_cert_file"`
+	ClientKeyFile  string `yaml:"client_key_file"`
+	// InsecureSkipVerify disables the verification of server certificate,
+	// it should only be used for private cloud with self-signed certificate.
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify"`
+	// IdleConnTimeout and TLSHandshakeTimeout are in seconds.
+	MaxIdleConns        int `yaml:"max_idle_conns"`
+	MaxIdleConnsPerHost int `yaml:"max_idle_conns_per_host"`
+	IdleConnTimeout     int `yaml:"idle_conn_timeout"`
+	TLSHandshakeTimeout int `yaml:"tls_handshake_timeout"`
+
 	Zone string `yaml:"zone"`
 
+	// Profile is the name of the profile to load in the "profiles" section
+	// of configuration file, see GetProfile.
+	Profile string `yaml:"-"`
+
 	CredentialProxyProtocol string `yaml:"credential_proxy_protocol"`
 	CredentialProxyHost     string `yaml:"credential_proxy_host"`
 	CredentialProxyPort     int    `yaml:"credential_proxy_port"`
 	CredentialProxyURI      string `yaml:"credential_proxy_uri"`
+	CredentialProcess       string `yaml:"credential_process"`
+	// CredentialRefreshMargin is the number of seconds before the expiration
+	// of temporary credentials to start refreshing them.
+	CredentialRefreshMargin int `yaml:"credential_refresh_margin"`
+
+	// Credentials resolves and caches the credentials when no access keys
+	// are set, it uses DefaultCredentialsProvider by default.
+	Credentials *credentials.Credentials `yaml:"-"`
 
+	// Deprecated: Token and Expiration are no longer used, the IAM token is
+	// kept in Credentials.
 	Token      string
 	Expiration int64
 
 	Connection *http.Client
 }
 
+// GetRetryer returns the Retryer of this Config.
+func (c *Config) GetRetryer() Retryer {
+	if c.Retryer != nil {
+		return c.Retryer
+	}
+	return NewDefaultRetryer(c)
+}
+
 // New create a Config with given AccessKeyID and SecretAccessKey.
 func New(accessKeyID, secretAccessKey string) (*Config, error) {
 	config, err := NewDefault()
@@ -68,8 +131,6 @@ func New(accessKeyID, secretAccessKey string) (*Config, error) {
 	config.AccessKeyID = accessKeyID
 	config.SecretAccessKey = secretAccessKey
 
-	config.Connection = &http.Client{}
-
 	return config, nil
 }
 
@@ -109,7 +170,6 @@ func NewWithEndpoint(accessKeyID, secretAccessKey, endpoint string) (*Config, er
 	config.SecretAccessKey = secretAccessKey
 	config.Protocol = qcURL.Scheme
 	config.URI = qcURL.Path
-	config.Connection = &http.Client{}
 	return config, nil
 }
 
@@ -121,14 +181,9 @@ func NewDefault() (*Config, error) {
 		return nil, err
 	}
 
-	timeout := time.Duration(config.ConnectionTimeout) * time.Second
-	transport := &http.Transport{
-		Dial: func(network, addr string) (net.Conn, error) {
-			return net.DialTimeout(network, addr, timeout)
-		},
-	}
-	config.Connection = &http.Client{
-		Transport: transport,
+	err = config.InitHTTPClient()
+	if err != nil {
+		return nil, err
 	}
 
 	return config, nil
@@ -143,7 +198,12 @@ func (c *Config) LoadDefaultConfig() error {
 		return err
 	}
 
-	logger.SetLevel(c.LogLevel)
+	err = logger.SetLevel(c.LogLevel)
+	if err != nil {
+		return err
+	}
+
+	c.GetCredentials()
 
 	return nil
 }
@@ -176,8 +236,11 @@ func (c *Config) LoadConfigFromFilepath(filepath string) error {
 	return c.LoadConfigFromContent(configYAML)
 }
 
-// LoadConfigFromContent loads configuration from a given byte slice.
-// It returns error if yaml decode failed.
+// LoadConfigFromContent loads configuration from a given byte slice,
+// including the selected profile in the "profiles" section, and then
+// overrides it with QY_* environment variables, see LoadEnvConfig.
+// It returns error if yaml decode failed, the profile is not found or the
+// configuration is not valid.
 func (c *Config) LoadConfigFromContent(content []byte) error {
 	c.LoadDefaultConfig()
 
@@ -187,16 +250,33 @@ func (c *Config) LoadConfigFromContent(content []byte) error {
 		return err
 	}
 
-	logger.SetLevel(c.LogLevel)
+	err = c.loadProfile(content)
+	if err != nil {
+		logger.Error("Config profile error: " + err.Error())
+		return err
+	}
+
+	err = c.LoadEnvConfig()
+	if err != nil {
+		logger.Error("Config environment error: " + err.Error())
+		return err
+	}
+
+	err = c.Validate()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
 
-	timeout := time.Duration(c.ConnectionTimeout) * time.Second
-	transport := &http.Transport{
-		Dial: func(network, addr string) (net.Conn, error) {
-			return net.DialTimeout(network, addr, timeout)
-		},
+	err = logger.SetLevel(c.LogLevel)
+	if err != nil {
+		return err
 	}
-	c.Connection = &http.Client{
-		Transport: transport,
+
+	err = c.InitHTTPClient()
+	if err != nil {
+		logger.Error("Config transport error: " + err.Error())
+		return err
 	}
 
 	return nil
@@ -17,6 +17,8 @@
 package config
 
 import (
+	"context"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -118,3 +120,30 @@ func TestNewWithEndpoint(t *testing.T) {
 	assert.Equal(t, "/iaas", config.URI)
 
 }
+
+func TestConfig_RetrieveCredentials(t *testing.T) {
+	os.Setenv("QY_ACCESS_KEY_ID", "EnvAccessKeyID")
+	os.Setenv("QY_SECRET_ACCESS_KEY", "EnvSecretAccessKey")
+	defer os.Unsetenv("QY_ACCESS_KEY_ID")
+	defer os.Unsetenv("QY_SECRET_ACCESS_KEY")
+
+	config := &Config{CredentialRefreshMargin: 60}
+	value, err := config.RetrieveCredentials(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "EnvAccessKeyID", value.AccessKeyID)
+	creds := config.Credentials
+	assert.NotNil(t, creds)
+
+	// The credentials are cached in the Config instead of resolved again.
+	os.Setenv("QY_ACCESS_KEY_ID", "OtherAccessKeyID")
+	value, err = config.RetrieveCredentials(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "EnvAccessKeyID", value.AccessKeyID)
+	assert.Equal(t, creds, config.GetCredentials())
+
+	config.AccessKeyID = "AccessKeyID"
+	config.SecretAccessKey = "SecretAccessKey"
+	value, err = config.RetrieveCredentials(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "AccessKeyID", value.AccessKeyID)
+}
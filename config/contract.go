@@ -36,6 +36,24 @@ protocol: 'https'
 uri: '/iaas'
 connection_retries: 3
 connection_timeout: 30
+max_idle_conns: 100
+max_idle_conns_per_host: 10
+idle_conn_timeout: 90
+tls_handshake_timeout: 10
+# Proxy for API requests, HTTP_PROXY and HTTPS_PROXY are used if not set.
+#proxy_url: 'http://proxy.example.com:3128'
+# CA certificates and client certificate in PEM format for private cloud.
+#ca_file: '/etc/qingcloud/ca.pem'
+#client_cert_file: '/etc/qingcloud/client.pem'
+#client_key_file: '/etc/qingcloud/client-key.pem'
+#insecure_skip_verify: false
+# QingCloud ret codes which will be retried, defaults to [1500, 5100, 5300].
+#retryable_ret_codes: [1500, 5100, 5300]
+# Client side rate limit of API requests per second, slowing down when throttled.
+#rate_limit: 10
+#rate_burst: 20
+#api_rate_limits:
+#  RunInstances: 1
 
 # Valid log levels are "debug", "info", "warn", "error", and "fatal".
 log_level: 'warn'
@@ -0,0 +1,130 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/credentials"
+)
+
+// DefaultCredentialProxyHost is default credential proxy host
+const DefaultCredentialProxyHost = "169.254.169.254"
+
+// DefaultCredentialProxyPort is default credential proxy port
+const DefaultCredentialProxyPort = 80
+
+// DefaultCredentialProxyProtocol is default credential proxy protocol
+const DefaultCredentialProxyProtocol = "http"
+
+// DefaultCredentialProxyURI is default credential proxy URI
+const DefaultCredentialProxyURI = "/latest/meta-data/security-credentials"
+
+// DefaultCredentialProcessTimeout is the default timeout of credential process.
+const DefaultCredentialProcessTimeout = 30 * time.Second
+
+// RetrieveCredentials returns the credentials to sign requests with.
+// The access keys set on the Config take precedence, otherwise the
+// credentials are resolved and cached by Credentials.
+func (c *Config) RetrieveCredentials(ctx context.Context) (credentials.Value, error) {
+	if c.AccessKeyID != "" || c.SecretAccessKey != "" {
+		return credentials.NewStaticProvider(c.AccessKeyID, c.SecretAccessKey).Retrieve(ctx)
+	}
+
+	return c.GetCredentials().Get(ctx)
+}
+
+// credentialsMutex guards the lazy initialization of Credentials, a mutex
+// in Config would break the configs copied by value.
+var credentialsMutex sync.Mutex
+
+// GetCredentials returns the Credentials of this Config. If it's nil, one
+// using DefaultCredentialsProvider is created once and kept in the Config,
+// so the credentials are cached across requests. CredentialRefreshMargin
+// is applied to it when set.
+func (c *Config) GetCredentials() *credentials.Credentials {
+	credentialsMutex.Lock()
+	defer credentialsMutex.Unlock()
+
+	if c.Credentials == nil {
+		c.Credentials = credentials.NewCredentials(&defaultProvider{config: c})
+	}
+	if c.CredentialRefreshMargin > 0 {
+		c.Credentials.SetExpiryWindow(time.Duration(c.CredentialRefreshMargin) * time.Second)
+	}
+	return c.Credentials
+}
+
+// DefaultCredentialsProvider returns the provider chain used when no access
+// keys are set on the Config. It tries QY_ACCESS_KEY_ID and
+// QY_SECRET_ACCESS_KEY environment variables, then the user config file,
+// then the credential process if configured, and then the credential proxy.
+func (c *Config) DefaultCredentialsProvider() credentials.Provider {
+	providers := []credentials.Provider{
+		&credentials.EnvProvider{},
+		&credentials.FileProvider{Filename: GetUserConfigFilePath(), Profile: c.GetProfile()},
+	}
+	if c.CredentialProcess != "" {
+		providers = append(providers, &credentials.ProcessProvider{
+			Command: c.CredentialProcess,
+			Timeout: DefaultCredentialProcessTimeout,
+		})
+	}
+	providers = append(providers, &credentials.MetadataProvider{
+		URL: c.GetCredentialProxyURL(),
+	})
+
+	return credentials.NewChainProvider(providers...)
+}
+
+// GetCredentialProxyURL returns the URL of the credential proxy server.
+func (c *Config) GetCredentialProxyURL() string {
+	protocol := DefaultCredentialProxyProtocol
+	if c.CredentialProxyProtocol != "" {
+		protocol = c.CredentialProxyProtocol
+	}
+
+	host := DefaultCredentialProxyHost
+	if c.CredentialProxyHost != "" {
+		host = c.CredentialProxyHost
+	}
+
+	port := DefaultCredentialProxyPort
+	if c.CredentialProxyPort != 0 {
+		port = c.CredentialProxyPort
+	}
+
+	uri := DefaultCredentialProxyURI
+	if c.CredentialProxyURI != "" {
+		uri = c.CredentialProxyURI
+	}
+
+	return fmt.Sprintf("%s://%s:%d%s", protocol, host, port, uri)
+}
+
+// defaultProvider resolves the default provider chain on each retrieval,
+// so that changes to the Config are picked up by the cached Credentials.
+type defaultProvider struct {
+	config *Config
+}
+
+func (p *defaultProvider) Retrieve(ctx context.Context) (credentials.Value, error) {
+	return p.config.DefaultCredentialsProvider().Retrieve(ctx)
+}
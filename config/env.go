@@ -0,0 +1,82 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Environment variables which override the loaded configuration.
+const (
+	EnvHost                    = "QY_HOST"
+	EnvPort                    = "QY_PORT"
+	EnvProtocol                = "QY_PROTOCOL"
+	EnvURI                     = "QY_URI"
+	EnvZone                    = "QY_ZONE"
+	EnvConnectionRetries       = "QY_CONNECTION_RETRIES"
+	EnvConnectionTimeout       = "QY_CONNECTION_TIMEOUT"
+	EnvLogLevel                = "QY_LOG_LEVEL"
+	EnvCredentialProxyProtocol = "QY_CREDENTIAL_PROXY_PROTOCOL"
+	EnvCredentialProxyHost     = "QY_CREDENTIAL_PROXY_HOST"
+	EnvCredentialProxyPort     = "QY_CREDENTIAL_PROXY_PORT"
+	EnvCredentialProxyURI      = "QY_CREDENTIAL_PROXY_URI"
+	EnvCredentialProcess       = "QY_CREDENTIAL_PROCESS"
+)
+
+// LoadEnvConfig overrides configuration with the QY_* environment variables
+// which are set and not empty.
+// It returns error if the value of an integer variable is not valid.
+func (c *Config) LoadEnvConfig() error {
+	stringFields := map[string]*string{
+		EnvHost:                    &c.Host,
+		EnvProtocol:                &c.Protocol,
+		EnvURI:                     &c.URI,
+		EnvZone:                    &c.Zone,
+		EnvLogLevel:                &c.LogLevel,
+		EnvCredentialProxyProtocol: &c.CredentialProxyProtocol,
+		EnvCredentialProxyHost:     &c.CredentialProxyHost,
+		EnvCredentialProxyURI:      &c.CredentialProxyURI,
+		EnvCredentialProcess:       &c.CredentialProcess,
+	}
+	for name, field := range stringFields {
+		if value := os.Getenv(name); value != "" {
+			*field = value
+		}
+	}
+
+	intFields := map[string]*int{
+		EnvPort:                &c.Port,
+		EnvConnectionRetries:   &c.ConnectionRetries,
+		EnvConnectionTimeout:   &c.ConnectionTimeout,
+		EnvCredentialProxyPort: &c.CredentialProxyPort,
+	}
+	for name, field := range intFields {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf(`%s value "%s" is not an integer`, name, value)
+		}
+		*field = number
+	}
+
+	return nil
+}
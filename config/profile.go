@@ -0,0 +1,107 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/yunify/qingcloud-sdk-go/utils"
+)
+
+// EnvProfile is the environment variable which selects the profile.
+const EnvProfile = "QY_PROFILE"
+
+// DefaultProfile is the profile every other profile inherits from.
+const DefaultProfile = "default"
+
+// NewFromProfile create a Config from the profile with the given name in
+// ~/.qingcloud/config.yaml, the profile in QY_PROFILE environment variable
+// is used if the name is empty.
+func NewFromProfile(name string) (*Config, error) {
+	config, err := NewDefault()
+	if err != nil {
+		return nil, err
+	}
+
+	config.Profile = name
+	err = config.LoadUserConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// GetProfile returns the name of the profile to load, which is the Profile
+// of this Config, or QY_PROFILE environment variable, or "default".
+func (c *Config) GetProfile() string {
+	if c.Profile != "" {
+		return c.Profile
+	}
+	if profile := os.Getenv(EnvProfile); profile != "" {
+		return profile
+	}
+	return DefaultProfile
+}
+
+// loadProfile applies the "default" profile and then the selected profile
+// in the "profiles" section of the content. The keys of a profile override
+// the top level keys, and the missing ones are inherited.
+// It returns error if a profile other than "default" is not found, except
+// when the profile is selected by QY_PROFILE environment variable and the
+// content has no profiles at all.
+func (c *Config) loadProfile(content []byte) error {
+	document := &struct {
+		Profiles map[string]map[string]interface{} `yaml:"profiles"`
+	}{}
+	_, err := utils.YAMLDecode(content, document)
+	if err != nil {
+		return err
+	}
+
+	if c.Profile == "" && len(document.Profiles) == 0 {
+		return nil
+	}
+
+	name := c.GetProfile()
+	names := []string{DefaultProfile}
+	if name != DefaultProfile {
+		if _, ok := document.Profiles[name]; !ok {
+			return fmt.Errorf(`profile "%s" not found`, name)
+		}
+		names = append(names, name)
+	}
+
+	for _, name := range names {
+		profile, ok := document.Profiles[name]
+		if !ok {
+			continue
+		}
+
+		profileContent, err := utils.YAMLEncode(profile)
+		if err != nil {
+			return err
+		}
+		_, err = utils.YAMLDecode(profileContent, c)
+		if err != nil {
+			return fmt.Errorf(`profile "%s": %s`, name, err.Error())
+		}
+	}
+
+	return nil
+}
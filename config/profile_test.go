@@ -0,0 +1,86 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const profileContent = `
+qy_access_key_id: 'access_key_id'
+qy_secret_access_key: 'secret_access_key'
+zone: 'pek3a'
+
+profiles:
+  default:
+    zone: 'sh1a'
+  staging:
+    host: 'api.staging.qingcloud.com'
+    qy_access_key_id: 'staging_access_key_id'
+`
+
+func TestConfig_LoadProfile(t *testing.T) {
+	config := Config{}
+	assert.Nil(t, config.LoadConfigFromContent([]byte(profileContent)))
+	assert.Equal(t, "access_key_id", config.AccessKeyID)
+	assert.Equal(t, "sh1a", config.Zone)
+	assert.Equal(t, "api.qingcloud.com", config.Host)
+
+	config = Config{Profile: "staging"}
+	assert.Nil(t, config.LoadConfigFromContent([]byte(profileContent)))
+	assert.Equal(t, "staging_access_key_id", config.AccessKeyID)
+	assert.Equal(t, "secret_access_key", config.SecretAccessKey)
+	assert.Equal(t, "sh1a", config.Zone)
+	assert.Equal(t, "api.staging.qingcloud.com", config.Host)
+
+	config = Config{Profile: "production"}
+	err := config.LoadConfigFromContent([]byte(profileContent))
+	assert.Equal(t, `profile "production" not found`, err.Error())
+}
+
+func TestConfig_GetProfile(t *testing.T) {
+	defer os.Setenv(EnvProfile, os.Getenv(EnvProfile))
+
+	os.Setenv(EnvProfile, "")
+	assert.Equal(t, DefaultProfile, (&Config{}).GetProfile())
+
+	os.Setenv(EnvProfile, "staging")
+	assert.Equal(t, "staging", (&Config{}).GetProfile())
+	assert.Equal(t, "production", (&Config{Profile: "production"}).GetProfile())
+}
+
+func TestConfig_LoadProfileFromEnv(t *testing.T) {
+	defer os.Setenv(EnvProfile, os.Getenv(EnvProfile))
+	os.Setenv(EnvProfile, "staging")
+
+	config := Config{}
+	assert.Nil(t, config.LoadConfigFromContent([]byte(profileContent)))
+	assert.Equal(t, "staging_access_key_id", config.AccessKeyID)
+
+	// Content without profiles is loaded as it is.
+	config = Config{}
+	assert.Nil(t, config.LoadConfigFromContent([]byte("zone: 'pek3a'\n")))
+	assert.Equal(t, "pek3a", config.Zone)
+
+	os.Setenv(EnvProfile, "production")
+	config = Config{}
+	err := config.LoadConfigFromContent([]byte(profileContent))
+	assert.Equal(t, `profile "production" not found`, err.Error())
+}
@@ -0,0 +1,266 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+// DefaultMinRateFactor is the lowest fraction of the configured rates the
+// default rate limiter slows down to when requests are throttled.
+const DefaultMinRateFactor = 0.1
+
+// DefaultRateRecovery is the fraction of the configured rates the default
+// rate limiter recovers by with each successful request after throttling.
+const DefaultRateRecovery = 0.02
+
+// A RateLimiter limits the rate of API requests before they are sent.
+type RateLimiter interface {
+	// Wait blocks until a request of the API may be sent.
+	// It returns error if the context is done before that.
+	Wait(ctx context.Context, apiName string) error
+	// Observe is called with the result of each sent request, so that the
+	// rate limiter can adapt to throttling.
+	Observe(apiName string, err error)
+}
+
+// DefaultRateLimiter limits requests with a global token bucket and optional
+// token buckets of API names. When a request is throttled, the rates of all
+// buckets are halved down to MinRateFactor of the configured rates, and they
+// recover by RateRecovery with each successful request.
+type DefaultRateLimiter struct {
+	Global        *TokenBucket
+	APIs          map[string]*TokenBucket
+	MinRateFactor float64
+	RateRecovery  float64
+
+	mutex  sync.Mutex
+	factor float64
+}
+
+// NewDefaultRateLimiter create a DefaultRateLimiter allowing rate requests
+// per second with bursts of burst requests, and the given rates of API
+// names. A zero rate means no global limit.
+func NewDefaultRateLimiter(rate float64, burst int, apiRates map[string]float64) *DefaultRateLimiter {
+	l := &DefaultRateLimiter{
+		APIs:          map[string]*TokenBucket{},
+		MinRateFactor: DefaultMinRateFactor,
+		RateRecovery:  DefaultRateRecovery,
+		factor:        1,
+	}
+	if rate > 0 {
+		l.Global = NewTokenBucket(rate, burst)
+	}
+	for apiName, apiRate := range apiRates {
+		l.APIs[apiName] = NewTokenBucket(apiRate, 0)
+	}
+	return l
+}
+
+// Wait blocks until both the global bucket and the bucket of the API have
+// a token for the request.
+func (l *DefaultRateLimiter) Wait(ctx context.Context, apiName string) error {
+	factor := l.Factor()
+	buckets := []*TokenBucket{}
+	if l.Global != nil {
+		buckets = append(buckets, l.Global)
+	}
+	if bucket, ok := l.APIs[apiName]; ok {
+		buckets = append(buckets, bucket)
+	}
+
+	now := time.Now()
+	delay := time.Duration(0)
+	for _, bucket := range buckets {
+		if d := bucket.reserve(now, factor); d > delay {
+			delay = d
+		}
+	}
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		for _, bucket := range buckets {
+			bucket.release()
+		}
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Observe slows the rates down if the request is throttled, and speeds
+// them up otherwise.
+func (l *DefaultRateLimiter) Observe(apiName string, err error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if goerrors.Is(err, errors.ErrThrottled) {
+		l.factor = math.Max(l.factor/2, l.MinRateFactor)
+		return
+	}
+	if err == nil && l.factor < 1 {
+		l.factor = math.Min(l.factor+l.RateRecovery, 1)
+	}
+}
+
+// Factor returns the current fraction of the configured rates.
+func (l *DefaultRateLimiter) Factor() float64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.factor
+}
+
+// A TokenBucket holds up to Burst tokens, refilled at Rate tokens per
+// second. Each request takes a token, and waits for it if there's none.
+type TokenBucket struct {
+	Rate  float64
+	Burst int
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket create a full TokenBucket, a burst of zero means the
+// integer part of rate, but at least one.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst <= 0 {
+		burst = int(math.Max(1, rate))
+	}
+	return &TokenBucket{
+		Rate:   rate,
+		Burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token refilled at factor of Rate, and returns how long to
+// wait before the token is available. The tokens go negative when requests
+// wait, so that the waiting requests are spaced out.
+func (b *TokenBucket) reserve(now time.Time, factor float64) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	rate := b.Rate * factor
+	if now.After(b.last) {
+		b.tokens = math.Min(b.tokens+now.Sub(b.last).Seconds()*rate, float64(b.Burst))
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 || rate <= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / rate * float64(time.Second))
+}
+
+// release gives back a reserved token which is not used.
+func (b *TokenBucket) release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.tokens = math.Min(b.tokens+1, float64(b.Burst))
+}
+
+// rateLimiterOptions is the part of Config which decides the rate limiter.
+type rateLimiterOptions struct {
+	accessKeyID   string
+	rateLimit     float64
+	rateBurst     int
+	apiRateLimits string
+}
+
+// rateLimiters caches the shared DefaultRateLimiters of the process by
+// access key and rate limits, the entries are never evicted.
+var rateLimiters = struct {
+	sync.Mutex
+	cache map[rateLimiterOptions]*DefaultRateLimiter
+}{cache: map[rateLimiterOptions]*DefaultRateLimiter{}}
+
+// GetRateLimiter returns the RateLimiter of this Config, see
+// GetRateLimiterWithContext. If the credentials can't be retrieved, the
+// shared DefaultRateLimiter is looked up by AccessKeyID instead.
+func (c *Config) GetRateLimiter() RateLimiter {
+	limiter, err := c.GetRateLimiterWithContext(context.Background())
+	if err != nil {
+		return c.sharedRateLimiter(c.AccessKeyID)
+	}
+	return limiter
+}
+
+// GetRateLimiterWithContext returns the RateLimiter of this Config. If
+// RateLimiter is not set, Configs whose credentials have the same access key
+// and the same rate limits share one DefaultRateLimiter, since QingCloud
+// limits the rate of each account. The shared rate limiters are kept in a
+// process-wide cache which is never evicted.
+// It returns nil if no rate limit is configured, or error if the
+// credentials can't be retrieved.
+func (c *Config) GetRateLimiterWithContext(ctx context.Context) (RateLimiter, error) {
+	if c.RateLimiter != nil {
+		return c.RateLimiter, nil
+	}
+	if c.RateLimit <= 0 && len(c.APIRateLimits) == 0 {
+		return nil, nil
+	}
+
+	value, err := c.RetrieveCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.sharedRateLimiter(value.AccessKeyID), nil
+}
+
+func (c *Config) sharedRateLimiter(accessKeyID string) RateLimiter {
+	if c.RateLimit <= 0 && len(c.APIRateLimits) == 0 {
+		return nil
+	}
+
+	apiRateLimits := []string{}
+	for apiName, rate := range c.APIRateLimits {
+		apiRateLimits = append(apiRateLimits, fmt.Sprintf("%s=%g", apiName, rate))
+	}
+	sort.Strings(apiRateLimits)
+	options := rateLimiterOptions{
+		accessKeyID:   accessKeyID,
+		rateLimit:     c.RateLimit,
+		rateBurst:     c.RateBurst,
+		apiRateLimits: strings.Join(apiRateLimits, ","),
+	}
+
+	rateLimiters.Lock()
+	defer rateLimiters.Unlock()
+
+	if limiter, ok := rateLimiters.cache[options]; ok {
+		return limiter
+	}
+	limiter := NewDefaultRateLimiter(c.RateLimit, c.RateBurst, c.APIRateLimits)
+	rateLimiters.cache[options] = limiter
+	return limiter
+}
@@ -0,0 +1,149 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yunify/qingcloud-sdk-go/credentials"
+	qcErrors "github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+func TestTokenBucket(t *testing.T) {
+	b := NewTokenBucket(10, 2)
+	now := time.Now()
+	assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+	assert.Equal(t, time.Duration(0), b.reserve(now, 1))
+	assert.Equal(t, 100*time.Millisecond, b.reserve(now, 1))
+	assert.Equal(t, 200*time.Millisecond, b.reserve(now, 1))
+
+	b.release()
+	assert.Equal(t, 200*time.Millisecond, b.reserve(now, 1))
+	assert.Equal(t, time.Duration(0), b.reserve(now.Add(time.Second), 1))
+
+	b = NewTokenBucket(10, 1)
+	assert.Equal(t, time.Duration(0), b.reserve(now, 0.5))
+	assert.Equal(t, 200*time.Millisecond, b.reserve(now, 0.5))
+
+	assert.Equal(t, 1, NewTokenBucket(0.5, 0).Burst)
+	assert.Equal(t, 20, NewTokenBucket(20, 0).Burst)
+}
+
+func TestDefaultRateLimiter_Wait(t *testing.T) {
+	l := NewDefaultRateLimiter(100, 1, map[string]float64{"RunInstances": 10})
+
+	start := time.Now()
+	assert.Nil(t, l.Wait(context.Background(), "DescribeInstances"))
+	assert.Nil(t, l.Wait(context.Background(), "DescribeInstances"))
+	assert.True(t, time.Since(start) >= 5*time.Millisecond)
+
+	// The burst of API buckets is their rate.
+	start = time.Now()
+	for i := 0; i < 11; i++ {
+		assert.Nil(t, l.Wait(context.Background(), "RunInstances"))
+	}
+	assert.True(t, time.Since(start) >= 90*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx, "RunInstances"))
+
+	l = NewDefaultRateLimiter(0, 0, map[string]float64{"RunInstances": 1})
+	assert.Nil(t, l.Global)
+	start = time.Now()
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, l.Wait(context.Background(), "DescribeInstances"))
+	}
+	assert.True(t, time.Since(start) < 10*time.Millisecond)
+}
+
+func TestDefaultRateLimiter_Observe(t *testing.T) {
+	l := NewDefaultRateLimiter(10, 0, nil)
+	throttled := &qcErrors.QingCloudError{RetCode: qcErrors.RetCodeTooManyRequests}
+
+	l.Observe("RunInstances", throttled)
+	assert.Equal(t, 0.5, l.Factor())
+	for i := 0; i < 10; i++ {
+		l.Observe("RunInstances", throttled)
+	}
+	assert.Equal(t, DefaultMinRateFactor, l.Factor())
+
+	l.Observe("RunInstances", errors.New("connection reset"))
+	assert.Equal(t, DefaultMinRateFactor, l.Factor())
+	l.Observe("RunInstances", nil)
+	assert.InDelta(t, DefaultMinRateFactor+DefaultRateRecovery, l.Factor(), 1e-9)
+	for i := 0; i < 100; i++ {
+		l.Observe("RunInstances", nil)
+	}
+	assert.Equal(t, float64(1), l.Factor())
+}
+
+func TestConfig_GetRateLimiter(t *testing.T) {
+	c, err := New("AccessKeyID", "SecretAccessKey")
+	assert.Nil(t, err)
+	assert.Nil(t, c.GetRateLimiter())
+
+	c.RateLimit = 10
+	c.APIRateLimits = map[string]float64{"RunInstances": 1, "DescribeJobs": 5}
+	limiter := c.GetRateLimiter()
+	assert.NotNil(t, limiter)
+
+	another, err := New("AccessKeyID", "SecretAccessKey")
+	assert.Nil(t, err)
+	another.RateLimit = 10
+	another.APIRateLimits = map[string]float64{"DescribeJobs": 5, "RunInstances": 1}
+	assert.True(t, limiter == another.GetRateLimiter())
+
+	another.AccessKeyID = "AnotherAccessKeyID"
+	assert.False(t, limiter == another.GetRateLimiter())
+
+	custom := NewDefaultRateLimiter(1, 1, nil)
+	c.RateLimiter = custom
+	assert.True(t, custom == c.GetRateLimiter())
+}
+
+func TestConfig_GetRateLimiterWithCredentials(t *testing.T) {
+	newConfig := func(accessKeyID string) *Config {
+		c, err := New("", "")
+		assert.Nil(t, err)
+		c.Credentials = credentials.NewCredentials(
+			credentials.NewStaticProvider(accessKeyID, "SecretAccessKey"))
+		c.RateLimit = 3
+		return c
+	}
+
+	limiter, err := newConfig("ProviderAccessKeyID").GetRateLimiterWithContext(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, limiter)
+
+	another, err := newConfig("ProviderAccessKeyID").GetRateLimiterWithContext(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, limiter == another)
+
+	another, err = newConfig("AnotherProviderAccessKeyID").GetRateLimiterWithContext(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, limiter == another)
+
+	c := newConfig("ProviderAccessKeyID")
+	c.Credentials = credentials.NewCredentials(credentials.NewStaticProvider("", ""))
+	_, err = c.GetRateLimiterWithContext(context.Background())
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,208 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+// DefaultRetryableRetCodes are the QingCloud ret codes retried by default,
+// they indicate the request was throttled or the server was too busy to
+// handle it and are safe to send again.
+var DefaultRetryableRetCodes = []int{
+	errors.RetCodeTooManyRequests,
+	errors.RetCodeServerBusy,
+	errors.RetCodeServiceUpdating,
+}
+
+// DefaultMinRetryDelay is the default delay before the first retry.
+const DefaultMinRetryDelay = 500 * time.Millisecond
+
+// DefaultMaxRetryDelay is the default upper bound of a single retry delay.
+const DefaultMaxRetryDelay = 20 * time.Second
+
+// DefaultRetryBudget is the retry budget shared by default retryers in this
+// process, it allows bursts of 100 retries refilled at 10 retries per second.
+var DefaultRetryBudget = NewRetryBudget(100, 10)
+
+// A Retryer decides whether a failed request should be sent again and how
+// long to wait before doing so. The error given to ShouldRetry is either a
+// transport error or a QingCloudError unpacked from the response body.
+type Retryer interface {
+	// MaxRetries returns the maximum number of retries of a request.
+	MaxRetries() int
+	// ShouldRetry reports whether a request failed with err should be retried.
+	ShouldRetry(err error) bool
+	// RetryDelay returns the delay before the given retry, counting from 1.
+	RetryDelay(retry int) time.Duration
+}
+
+// DefaultRetryer retries transport errors and retryable QingCloud ret codes
+// with exponential backoff and jitter, and stops early if the budget runs out.
+type DefaultRetryer struct {
+	NumMaxRetries     int
+	MinRetryDelay     time.Duration
+	MaxRetryDelay     time.Duration
+	RetryableRetCodes []int
+	Budget            *RetryBudget
+}
+
+// NewDefaultRetryer create a DefaultRetryer from the given Config.
+func NewDefaultRetryer(c *Config) *DefaultRetryer {
+	retCodes := c.RetryableRetCodes
+	if retCodes == nil {
+		retCodes = DefaultRetryableRetCodes
+	}
+
+	return &DefaultRetryer{
+		NumMaxRetries:     c.ConnectionRetries,
+		MinRetryDelay:     DefaultMinRetryDelay,
+		MaxRetryDelay:     DefaultMaxRetryDelay,
+		RetryableRetCodes: retCodes,
+		Budget:            DefaultRetryBudget,
+	}
+}
+
+// MaxRetries returns the maximum number of retries of a request.
+func (r *DefaultRetryer) MaxRetries() int {
+	return r.NumMaxRetries
+}
+
+// ShouldRetry reports whether a request failed with err should be retried.
+func (r *DefaultRetryer) ShouldRetry(err error) bool {
+	if !r.isRetryable(err) {
+		return false
+	}
+	if r.Budget != nil && !r.Budget.Acquire() {
+		return false
+	}
+	return true
+}
+
+func (r *DefaultRetryer) isRetryable(err error) bool {
+	switch e := err.(type) {
+	case nil:
+		return false
+	case *errors.QingCloudError:
+		return r.isRetryableQingCloudError(*e)
+	case errors.QingCloudError:
+		return r.isRetryableQingCloudError(e)
+	}
+
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+
+	// Errors returned by the HTTP client are transport errors, such as
+	// refused or reset connections and timeouts.
+	if _, ok := err.(*url.Error); ok {
+		return true
+	}
+	if _, ok := err.(net.Error); ok {
+		return true
+	}
+
+	return false
+}
+
+func (r *DefaultRetryer) isRetryableQingCloudError(e errors.QingCloudError) bool {
+	if e.RetCode == 0 {
+		// The request was rejected before reaching QingCloud API server.
+		return e.StatusCode == http.StatusTooManyRequests ||
+			e.StatusCode == http.StatusServiceUnavailable
+	}
+
+	for _, code := range r.RetryableRetCodes {
+		if code == e.RetCode {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryDelay returns the delay before the given retry, counting from 1.
+// The delay doubles with each retry up to MaxRetryDelay, and a random jitter
+// of up to half the delay is applied to spread concurrent retries.
+func (r *DefaultRetryer) RetryDelay(retry int) time.Duration {
+	if retry < 1 {
+		retry = 1
+	}
+
+	delay := r.MinRetryDelay
+	for i := 1; i < retry && delay < r.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if r.MaxRetryDelay > 0 && delay > r.MaxRetryDelay {
+		delay = r.MaxRetryDelay
+	}
+
+	half := int64(delay / 2)
+	if half <= 0 {
+		return delay
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// A RetryBudget limits how many retries may be issued over time, so that a
+// struggling server is not flooded with retries from many goroutines.
+type RetryBudget struct {
+	capacity   float64
+	refillRate float64
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRetryBudget create a RetryBudget holding capacity retries and refilled
+// with refillRate retries per second.
+func NewRetryBudget(capacity int, refillRate float64) *RetryBudget {
+	return &RetryBudget{
+		capacity:   float64(capacity),
+		refillRate: refillRate,
+		tokens:     float64(capacity),
+		last:       time.Now(),
+	}
+}
+
+// Acquire takes one retry from the budget.
+// It returns false if the budget is exhausted.
+func (b *RetryBudget) Acquire() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.refillRate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
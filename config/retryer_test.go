@@ -0,0 +1,76 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	qcErrors "github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+func TestDefaultRetryer_ShouldRetry(t *testing.T) {
+	c, err := NewDefault()
+	assert.Nil(t, err)
+
+	r := NewDefaultRetryer(c)
+	r.Budget = nil
+
+	assert.Equal(t, 3, r.MaxRetries())
+	assert.True(t, r.ShouldRetry(&url.Error{Op: "Get", Err: errors.New("connection reset")}))
+	assert.True(t, r.ShouldRetry(&qcErrors.QingCloudError{RetCode: 5100}))
+	assert.False(t, r.ShouldRetry(&qcErrors.QingCloudError{RetCode: 1400}))
+	assert.False(t, r.ShouldRetry(errors.New("json decode error")))
+
+	c.RetryableRetCodes = []int{1400}
+	r = NewDefaultRetryer(c)
+	r.Budget = nil
+	assert.True(t, r.ShouldRetry(qcErrors.QingCloudError{RetCode: 1400}))
+	assert.False(t, r.ShouldRetry(qcErrors.QingCloudError{RetCode: 5100}))
+}
+
+func TestDefaultRetryer_RetryDelay(t *testing.T) {
+	r := &DefaultRetryer{
+		MinRetryDelay: 100 * time.Millisecond,
+		MaxRetryDelay: time.Second,
+	}
+
+	for retry, max := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		delay := r.RetryDelay(retry)
+		assert.True(t, delay >= max/2, "retry %d delay %s", retry, delay)
+		assert.True(t, delay <= max, "retry %d delay %s", retry, delay)
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	b := NewRetryBudget(2, 0)
+	assert.True(t, b.Acquire())
+	assert.True(t, b.Acquire())
+	assert.False(t, b.Acquire())
+
+	r := &DefaultRetryer{RetryableRetCodes: DefaultRetryableRetCodes, Budget: b}
+	assert.False(t, r.ShouldRetry(&qcErrors.QingCloudError{RetCode: 5100}))
+}
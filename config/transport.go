@@ -0,0 +1,145 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// transportOptions is the part of Config which decides the transport.
+type transportOptions struct {
+	connectionTimeout   int
+	proxyURL            string
+	caFile              string
+	clientCertFile      string
+	clientKeyFile       string
+	insecureSkipVerify  bool
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	idleConnTimeout     int
+	tlsHandshakeTimeout int
+}
+
+var transports = struct {
+	sync.Mutex
+	cache map[transportOptions]*http.Transport
+}{cache: map[transportOptions]*http.Transport{}}
+
+// GetTransport returns the HTTP transport of this Config's connection
+// options. Configs with the same options share one transport, so that the
+// idle connections are reused, note that the CA file and client certificate
+// are only read when the transport is created.
+// It returns error if the proxy URL, CA file or client certificate is not
+// valid.
+func (c *Config) GetTransport() (*http.Transport, error) {
+	options := transportOptions{
+		connectionTimeout:   c.ConnectionTimeout,
+		proxyURL:            c.ProxyURL,
+		caFile:              c.CAFile,
+		clientCertFile:      c.ClientCertFile,
+		clientKeyFile:       c.ClientKeyFile,
+		insecureSkipVerify:  c.InsecureSkipVerify,
+		maxIdleConns:        c.MaxIdleConns,
+		maxIdleConnsPerHost: c.MaxIdleConnsPerHost,
+		idleConnTimeout:     c.IdleConnTimeout,
+		tlsHandshakeTimeout: c.TLSHandshakeTimeout,
+	}
+
+	transports.Lock()
+	defer transports.Unlock()
+
+	if transport, ok := transports.cache[options]; ok {
+		return transport, nil
+	}
+
+	transport, err := newTransport(options)
+	if err != nil {
+		return nil, err
+	}
+	transports.cache[options] = transport
+	return transport, nil
+}
+
+// InitHTTPClient sets the Connection of this Config to a client using the
+// shared transport, see GetTransport.
+func (c *Config) InitHTTPClient() error {
+	transport, err := c.GetTransport()
+	if err != nil {
+		return err
+	}
+
+	c.Connection = &http.Client{
+		Transport: transport,
+	}
+	return nil
+}
+
+func newTransport(options transportOptions) (*http.Transport, error) {
+	proxy := http.ProxyFromEnvironment
+	if options.proxyURL != "" {
+		proxyURL, err := url.Parse(options.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf(`proxy_url "%s" not valid: %s`, options.proxyURL, err.Error())
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: options.insecureSkipVerify,
+	}
+	if options.caFile != "" {
+		caPEM, err := ioutil.ReadFile(options.caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf(`no certificate found in ca_file "%s"`, options.caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if options.clientCertFile != "" || options.clientKeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(options.clientCertFile, options.clientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   time.Duration(options.connectionTimeout) * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return &http.Transport{
+		Proxy:               proxy,
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: time.Duration(options.tlsHandshakeTimeout) * time.Second,
+		MaxIdleConns:        options.maxIdleConns,
+		MaxIdleConnsPerHost: options.maxIdleConnsPerHost,
+		IdleConnTimeout:     time.Duration(options.idleConnTimeout) * time.Second,
+		ForceAttemptHTTP2:   true,
+	}, nil
+}
@@ -0,0 +1,78 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_GetTransport(t *testing.T) {
+	config, err := NewDefault()
+	assert.Nil(t, err)
+	anotherConfig, err := NewDefault()
+	assert.Nil(t, err)
+	assert.Equal(t, config.Connection.Transport, anotherConfig.Connection.Transport)
+
+	anotherConfig.ProxyURL = "http://proxy.example.com:3128"
+	transport, err := anotherConfig.GetTransport()
+	assert.Nil(t, err)
+	assert.NotEqual(t, config.Connection.Transport, transport)
+
+	request, _ := http.NewRequest("GET", "https://api.qingcloud.com/iaas/", nil)
+	proxyURL, err := transport.Proxy(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "proxy.example.com:3128", proxyURL.Host)
+
+	anotherConfig.ProxyURL = ""
+	anotherConfig.ClientCertFile = "/not/exist/client.pem"
+	anotherConfig.ClientKeyFile = "/not/exist/client-key.pem"
+	_, err = anotherConfig.GetTransport()
+	assert.NotNil(t, err)
+}
+
+func TestConfig_GetTransport_CAFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "qingcloud-config")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.Nil(t, ioutil.WriteFile(caFile, caPEM, 0600))
+
+	config, err := NewDefault()
+	assert.Nil(t, err)
+	_, err = config.Connection.Get(server.URL)
+	assert.NotNil(t, err)
+
+	config.CAFile = caFile
+	assert.Nil(t, config.InitHTTPClient())
+	response, err := config.Connection.Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	response.Body.Close()
+}
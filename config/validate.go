@@ -0,0 +1,98 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/yunify/qingcloud-sdk-go/logger"
+)
+
+// ValidationError contains all the problems found by Config.Validate.
+type ValidationError struct {
+	Errors []error
+}
+
+// Error returns the description of ValidationError.
+func (e *ValidationError) Error() string {
+	messages := []string{}
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return "config not valid: " + strings.Join(messages, "; ")
+}
+
+// Validate checks the protocol, port, URI, transport options, log level and
+// access keys of this Config.
+// It returns a *ValidationError with every problem found, or nil.
+func (c *Config) Validate() error {
+	errs := []error{}
+
+	if c.Host == "" {
+		errs = append(errs, fmt.Errorf("host is empty"))
+	}
+	if c.Protocol != "http" && c.Protocol != "https" {
+		errs = append(errs, fmt.Errorf(`protocol "%s" is not "http" or "https"`, c.Protocol))
+	}
+	if c.Port < 1 || c.Port > 65535 {
+		errs = append(errs, fmt.Errorf("port %d is not in range 1-65535", c.Port))
+	}
+	if c.URI != "" && !strings.HasPrefix(c.URI, "/") {
+		errs = append(errs, fmt.Errorf(`uri "%s" does not start with "/"`, c.URI))
+	}
+	if c.ConnectionRetries < 0 {
+		errs = append(errs, fmt.Errorf("connection_retries %d is negative", c.ConnectionRetries))
+	}
+	if c.ConnectionTimeout < 0 {
+		errs = append(errs, fmt.Errorf("connection_timeout %d is negative", c.ConnectionTimeout))
+	}
+	if c.RateLimit < 0 {
+		errs = append(errs, fmt.Errorf("rate_limit %g is negative", c.RateLimit))
+	}
+	if c.RateBurst < 0 {
+		errs = append(errs, fmt.Errorf("rate_burst %d is negative", c.RateBurst))
+	}
+	for apiName, rate := range c.APIRateLimits {
+		if rate <= 0 {
+			errs = append(errs, fmt.Errorf("api_rate_limits of %s %g is not positive", apiName, rate))
+		}
+	}
+	if c.ProxyURL != "" {
+		if _, err := url.Parse(c.ProxyURL); err != nil {
+			errs = append(errs, fmt.Errorf(`proxy_url "%s" not valid`, c.ProxyURL))
+		}
+	}
+	if (c.ClientCertFile == "") != (c.ClientKeyFile == "") {
+		errs = append(errs, fmt.Errorf("client_cert_file and client_key_file should be set together"))
+	}
+	if err := logger.CheckLevel(c.LogLevel); err != nil {
+		errs = append(errs, err)
+	}
+	if c.AccessKeyID != "" && c.SecretAccessKey == "" {
+		errs = append(errs, fmt.Errorf("qy_secret_access_key is empty while qy_access_key_id is set"))
+	}
+	if c.AccessKeyID == "" && c.SecretAccessKey != "" {
+		errs = append(errs, fmt.Errorf("qy_access_key_id is empty while qy_secret_access_key is set"))
+	}
+
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
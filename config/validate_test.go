@@ -0,0 +1,66 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	config, err := NewDefault()
+	assert.Nil(t, err)
+	assert.Nil(t, config.Validate())
+
+	config.Protocol = "ftp"
+	config.Port = 70000
+	config.URI = "iaas"
+	config.LogLevel = "verbose"
+	config.AccessKeyID = "AccessKeyID"
+	config.RateLimit = -1
+	config.APIRateLimits = map[string]float64{"RunInstances": 0}
+	err = config.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, 7, len(err.(*ValidationError).Errors))
+}
+
+func TestConfig_LoadEnvConfig(t *testing.T) {
+	defer os.Unsetenv(EnvHost)
+	defer os.Unsetenv(EnvPort)
+	defer os.Unsetenv(EnvZone)
+
+	os.Setenv(EnvHost, "api.private.com")
+	os.Setenv(EnvPort, "4433")
+	os.Setenv(EnvZone, "pek3a")
+
+	config := Config{}
+	assert.Nil(t, config.LoadConfigFromContent([]byte("zone: 'sh1a'\n")))
+	assert.Equal(t, "api.private.com", config.Host)
+	assert.Equal(t, 4433, config.Port)
+	assert.Equal(t, "pek3a", config.Zone)
+	assert.Equal(t, "https", config.Protocol)
+
+	os.Setenv(EnvPort, "https")
+	err := config.LoadConfigFromContent([]byte("zone: 'sh1a'\n"))
+	assert.Equal(t, `QY_PORT value "https" is not an integer`, err.Error())
+
+	os.Setenv(EnvPort, "0")
+	err = config.LoadConfigFromContent([]byte("zone: 'sh1a'\n"))
+	assert.Equal(t, "config not valid: port 0 is not in range 1-65535", err.Error())
+}
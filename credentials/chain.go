@@ -0,0 +1,55 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// A ChainProvider tries its providers in order and returns the credentials
+// of the first one which has credentials. A provider failing with other
+// errors than ErrNoCredentials stops the chain.
+type ChainProvider struct {
+	Providers []Provider
+}
+
+// NewChainProvider create a ChainProvider with the given providers.
+func NewChainProvider(providers ...Provider) *ChainProvider {
+	return &ChainProvider{Providers: providers}
+}
+
+// Retrieve returns the credentials of the first provider which has them.
+func (p *ChainProvider) Retrieve(ctx context.Context) (Value, error) {
+	for _, provider := range p.Providers {
+		value, err := provider.Retrieve(ctx)
+		if err == nil {
+			return value, nil
+		}
+		if !errors.Is(err, ErrNoCredentials) {
+			return Value{}, err
+		}
+	}
+
+	names := []string{}
+	for _, provider := range p.Providers {
+		names = append(names, fmt.Sprintf("%T", provider))
+	}
+	return Value{}, fmt.Errorf("%w, tried %s", ErrNoCredentials, strings.Join(names, ", "))
+}
@@ -0,0 +1,201 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+// Package credentials provides the access keys used to sign QingCloud API
+// requests, resolved from a chain of providers and cached until expired.
+package credentials
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrNoCredentials is returned by a Provider which has no credentials,
+// a ChainProvider moves on to the next provider when it gets this error.
+var ErrNoCredentials = errors.New("no credentials provided")
+
+// A Value is a set of credentials.
+type Value struct {
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// Token is the IAM token of temporary credentials, requests signed with
+	// a token are sent to the "/iam" URI.
+	Token string
+	// Expiration is the time the credentials expire, the zero value means
+	// the credentials never expire.
+	Expiration time.Time
+
+	// ProviderName is the name of the provider which provided the value.
+	ProviderName string
+}
+
+// IsExpired reports whether the credentials expire before the given time.
+func (v Value) IsExpired(now time.Time) bool {
+	return !v.Expiration.IsZero() && !now.Before(v.Expiration)
+}
+
+// A Provider retrieves credentials from a source.
+type Provider interface {
+	// Retrieve returns the credentials, or ErrNoCredentials if the source
+	// has no credentials.
+	Retrieve(ctx context.Context) (Value, error)
+}
+
+// DefaultExpiryWindow is the default time before the expiration of the
+// credentials to start refreshing them.
+const DefaultExpiryWindow = 5 * time.Minute
+
+// DefaultRetrieveTimeout is the default timeout of retrieving credentials.
+const DefaultRetrieveTimeout = 30 * time.Second
+
+// minRefreshInterval limits how often credentials are refreshed ahead of
+// their expiration, in case the provider keeps returning short-lived ones.
+const minRefreshInterval = 10 * time.Second
+
+// Credentials caches the credentials retrieved from a Provider and refreshes
+// them before they expire. It's safe for concurrent use, concurrent callers
+// share a single in-flight retrieval.
+type Credentials struct {
+	provider Provider
+
+	mutex          sync.Mutex
+	value          Value
+	retrieved      bool
+	forceRefresh   bool
+	expiryWindow   time.Duration
+	timeout        time.Duration
+	inflight       *retrieval
+	lastRetrieveAt time.Time
+}
+
+type retrieval struct {
+	done  chan struct{}
+	value Value
+	err   error
+}
+
+// NewCredentials create Credentials with the given Provider.
+func NewCredentials(provider Provider) *Credentials {
+	return &Credentials{
+		provider:     provider,
+		expiryWindow: DefaultExpiryWindow,
+		timeout:      DefaultRetrieveTimeout,
+	}
+}
+
+// SetExpiryWindow sets the time before the expiration of the credentials to
+// start refreshing them. Within the window the cached credentials are still
+// returned while they are refreshed in background.
+func (c *Credentials) SetExpiryWindow(window time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.expiryWindow = window
+}
+
+// SetRetrieveTimeout sets the timeout of retrieving credentials from the
+// provider, the retrieval is shared by callers so it doesn't follow the
+// context of any of them.
+func (c *Credentials) SetRetrieveTimeout(timeout time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.timeout = timeout
+}
+
+// Get returns the cached credentials. It waits for the credentials to be
+// retrieved from the provider if they are not retrieved yet, expired or
+// expired by Expire, or until the context is done.
+func (c *Credentials) Get(ctx context.Context) (Value, error) {
+	c.mutex.Lock()
+	now := time.Now()
+	if c.retrieved && !c.forceRefresh && !c.value.IsExpired(now) {
+		value := c.value
+		if c.value.IsExpired(now.Add(c.expiryWindow)) &&
+			now.Sub(c.lastRetrieveAt) >= minRefreshInterval {
+			c.retrieve()
+		}
+		c.mutex.Unlock()
+		return value, nil
+	}
+	r := c.retrieve()
+	c.mutex.Unlock()
+
+	select {
+	case <-r.done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return Value{}, ctx.Err()
+	}
+}
+
+// retrieve starts retrieving the credentials unless it's in progress,
+// it must be called with the mutex held.
+func (c *Credentials) retrieve() *retrieval {
+	if c.inflight != nil {
+		return c.inflight
+	}
+
+	r := &retrieval{done: make(chan struct{})}
+	c.inflight = r
+	c.lastRetrieveAt = time.Now()
+	timeout := c.timeout
+
+	go func() {
+		ctx := context.Background()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		value, err := c.provider.Retrieve(ctx)
+
+		c.mutex.Lock()
+		if err == nil {
+			c.value = value
+			c.retrieved = true
+			c.forceRefresh = false
+		}
+		c.inflight = nil
+		c.mutex.Unlock()
+
+		r.value, r.err = value, err
+		close(r.done)
+	}()
+
+	return r
+}
+
+// Expire marks the cached credentials expired, the next Get retrieves them
+// from the provider again.
+func (c *Credentials) Expire() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.forceRefresh = true
+}
+
+// IsExpired reports whether the cached credentials need to be retrieved.
+func (c *Credentials) IsExpired() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return !c.retrieved || c.forceRefresh || c.value.IsExpired(time.Now())
+}
@@ -0,0 +1,254 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingProvider struct {
+	value Value
+	count int
+}
+
+func (p *countingProvider) Retrieve(ctx context.Context) (Value, error) {
+	p.count++
+	return p.value, nil
+}
+
+func TestCredentials_Get(t *testing.T) {
+	p := &countingProvider{value: Value{
+		AccessKeyID:     "AccessKeyID",
+		SecretAccessKey: "SecretAccessKey",
+		Expiration:      time.Now().Add(time.Hour),
+	}}
+	c := NewCredentials(p)
+	assert.True(t, c.IsExpired())
+
+	value, err := c.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "AccessKeyID", value.AccessKeyID)
+	_, err = c.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, p.count)
+	assert.False(t, c.IsExpired())
+
+	c.Expire()
+	assert.True(t, c.IsExpired())
+	_, err = c.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, p.count)
+
+	p.value.Expiration = time.Now().Add(-time.Second)
+	c.Expire()
+	c.Get(context.Background())
+	c.Get(context.Background())
+	assert.Equal(t, 4, p.count)
+}
+
+func TestChainProvider(t *testing.T) {
+	os.Setenv(EnvAccessKeyID, "")
+	os.Setenv(EnvSecretAccessKey, "")
+
+	chain := NewChainProvider(
+		NewStaticProvider("", ""),
+		&EnvProvider{},
+		NewStaticProvider("AccessKeyID", "SecretAccessKey"),
+	)
+	value, err := chain.Retrieve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "AccessKeyID", value.AccessKeyID)
+	assert.Equal(t, StaticProviderName, value.ProviderName)
+
+	os.Setenv(EnvAccessKeyID, "EnvAccessKeyID")
+	os.Setenv(EnvSecretAccessKey, "EnvSecretAccessKey")
+	defer os.Unsetenv(EnvAccessKeyID)
+	defer os.Unsetenv(EnvSecretAccessKey)
+	value, err = chain.Retrieve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "EnvAccessKeyID", value.AccessKeyID)
+	assert.Equal(t, EnvProviderName, value.ProviderName)
+
+	chain = NewChainProvider(NewStaticProvider("AccessKeyID", ""), &EnvProvider{})
+	_, err = chain.Retrieve(context.Background())
+	assert.Equal(t, "secret access key not provided", err.Error())
+
+	chain = NewChainProvider(NewStaticProvider("", ""))
+	_, err = chain.Retrieve(context.Background())
+	assert.True(t, errors.Is(err, ErrNoCredentials))
+}
+
+func TestFileProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qingcloud-credentials")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "config.yaml")
+	p := &FileProvider{Filename: filename}
+	_, err = p.Retrieve(context.Background())
+	assert.Equal(t, ErrNoCredentials, err)
+
+	content := "qy_access_key_id: 'AccessKeyID'\nqy_secret_access_key: 'SecretAccessKey'\n"
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(content), 0600))
+	value, err := p.Retrieve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "AccessKeyID", value.AccessKeyID)
+	assert.Equal(t, "SecretAccessKey", value.SecretAccessKey)
+
+	content += "profiles:\n  staging:\n    qy_access_key_id: 'StagingAccessKeyID'\n"
+	assert.Nil(t, ioutil.WriteFile(filename, []byte(content), 0600))
+	p.Profile = "staging"
+	value, err = p.Retrieve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "StagingAccessKeyID", value.AccessKeyID)
+	assert.Equal(t, "SecretAccessKey", value.SecretAccessKey)
+}
+
+func TestMetadataProvider(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id_token":"Token","access_key":"AccessKeyID","secret_key":"SecretAccessKey","expiration":%d}`, expiration)
+	}))
+	defer server.Close()
+
+	p := &MetadataProvider{URL: server.URL}
+	value, err := p.Retrieve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "AccessKeyID", value.AccessKeyID)
+	assert.Equal(t, "Token", value.Token)
+	assert.Equal(t, expiration, value.Expiration.Unix())
+	assert.Equal(t, MetadataProviderName, value.ProviderName)
+}
+
+func TestProcessProvider(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential process test requires sh")
+	}
+
+	p := &ProcessProvider{
+		Command: `echo '{"access_key":"AccessKeyID","secret_key":"SecretAccessKey"}'`,
+		Timeout: 5 * time.Second,
+	}
+	value, err := p.Retrieve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "AccessKeyID", value.AccessKeyID)
+	assert.True(t, value.Expiration.IsZero())
+
+	p.Command = "exit 1"
+	_, err = p.Retrieve(context.Background())
+	assert.NotNil(t, err)
+}
+
+type slowProvider struct {
+	mutex   sync.Mutex
+	count   int
+	release chan struct{}
+	ttl     time.Duration
+}
+
+func (p *slowProvider) Retrieve(ctx context.Context) (Value, error) {
+	p.mutex.Lock()
+	p.count++
+	p.mutex.Unlock()
+
+	<-p.release
+	return Value{
+		AccessKeyID:     "AccessKeyID",
+		SecretAccessKey: "SecretAccessKey",
+		Expiration:      time.Now().Add(p.ttl),
+	}, nil
+}
+
+func (p *slowProvider) Count() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.count
+}
+
+func TestCredentials_GetConcurrently(t *testing.T) {
+	p := &slowProvider{release: make(chan struct{}), ttl: time.Hour}
+	c := NewCredentials(p)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := c.Get(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, "AccessKeyID", value.AccessKeyID)
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := c.Get(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	close(p.release)
+	wg.Wait()
+	assert.Equal(t, 1, p.Count())
+}
+
+func TestCredentials_GetWithinExpiryWindow(t *testing.T) {
+	p := &slowProvider{release: make(chan struct{}), ttl: time.Minute}
+	close(p.release)
+	c := NewCredentials(p)
+	c.SetExpiryWindow(2 * time.Minute)
+
+	first, err := c.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, p.Count())
+
+	// The credentials expire within the window, they are still returned
+	// while being refreshed in background.
+	c.mutex.Lock()
+	c.lastRetrieveAt = time.Time{}
+	c.mutex.Unlock()
+	value, err := c.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, first.Expiration, value.Expiration)
+
+	assert.Nil(t, waitFor(func() bool { return p.Count() == 2 }))
+	assert.Nil(t, waitFor(func() bool {
+		value, _ := c.Get(context.Background())
+		return value.Expiration.After(first.Expiration)
+	}))
+}
+
+func waitFor(f func() bool) error {
+	for i := 0; i < 100; i++ {
+		if f() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errors.New("timeout")
+}
@@ -0,0 +1,249 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/utils"
+)
+
+// Names of the providers in this package.
+const (
+	StaticProviderName   = "StaticProvider"
+	EnvProviderName      = "EnvProvider"
+	FileProviderName     = "FileProvider"
+	MetadataProviderName = "MetadataProvider"
+	ProcessProviderName  = "ProcessProvider"
+)
+
+// Environment variables read by EnvProvider.
+const (
+	EnvAccessKeyID     = "QY_ACCESS_KEY_ID"
+	EnvSecretAccessKey = "QY_SECRET_ACCESS_KEY"
+)
+
+// StaticProvider provides the given access keys.
+type StaticProvider struct {
+	Value
+}
+
+// NewStaticProvider create a StaticProvider with the given access keys.
+func NewStaticProvider(accessKeyID, secretAccessKey string) *StaticProvider {
+	return &StaticProvider{Value{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey}}
+}
+
+// Retrieve returns the static access keys.
+func (p *StaticProvider) Retrieve(ctx context.Context) (Value, error) {
+	return checkKeys(p.Value, StaticProviderName)
+}
+
+// EnvProvider provides the access keys in QY_ACCESS_KEY_ID and
+// QY_SECRET_ACCESS_KEY environment variables.
+type EnvProvider struct{}
+
+// Retrieve returns the access keys in environment variables.
+func (p *EnvProvider) Retrieve(ctx context.Context) (Value, error) {
+	return checkKeys(Value{
+		AccessKeyID:     os.Getenv(EnvAccessKeyID),
+		SecretAccessKey: os.Getenv(EnvSecretAccessKey),
+	}, EnvProviderName)
+}
+
+// FileProvider provides the access keys in a QingCloud configuration file,
+// such as "~/.qingcloud/config.yaml". The keys in the "default" profile and
+// then the keys in the named Profile override the top level keys.
+type FileProvider struct {
+	Filename string
+	Profile  string
+}
+
+type fileKeys struct {
+	AccessKeyID     string `yaml:"qy_access_key_id"`
+	SecretAccessKey string `yaml:"qy_secret_access_key"`
+}
+
+// Retrieve returns the access keys in the configuration file.
+func (p *FileProvider) Retrieve(ctx context.Context) (Value, error) {
+	content, err := ioutil.ReadFile(p.Filename)
+	if os.IsNotExist(err) {
+		return Value{}, ErrNoCredentials
+	}
+	if err != nil {
+		return Value{}, err
+	}
+
+	document := &struct {
+		fileKeys `yaml:",inline"`
+		Profiles map[string]fileKeys `yaml:"profiles"`
+	}{}
+	_, err = utils.YAMLDecode(content, document)
+	if err != nil {
+		return Value{}, fmt.Errorf("parse credentials in %s: %s", p.Filename, err.Error())
+	}
+
+	keys := document.fileKeys
+	for _, name := range []string{"default", p.Profile} {
+		profile, ok := document.Profiles[name]
+		if !ok {
+			continue
+		}
+		if profile.AccessKeyID != "" {
+			keys.AccessKeyID = profile.AccessKeyID
+		}
+		if profile.SecretAccessKey != "" {
+			keys.SecretAccessKey = profile.SecretAccessKey
+		}
+	}
+
+	return checkKeys(Value{
+		AccessKeyID:     keys.AccessKeyID,
+		SecretAccessKey: keys.SecretAccessKey,
+	}, FileProviderName)
+}
+
+// MetadataProvider provides the temporary credentials of the IAM instance
+// role from the credential proxy server, which is reachable on instances.
+type MetadataProvider struct {
+	URL    string
+	Client *http.Client
+}
+
+// Retrieve returns the credentials fetched from the credential proxy server.
+func (p *MetadataProvider) Retrieve(ctx context.Context) (Value, error) {
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	httpRequest, err := http.NewRequest("GET", p.URL, nil)
+	if err != nil {
+		return Value{}, err
+	}
+
+	response, err := client.Do(httpRequest.WithContext(ctx))
+	if err != nil {
+		return Value{}, err
+	}
+	defer response.Body.Close()
+
+	content, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return Value{}, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return Value{}, fmt.Errorf(
+			"credential proxy responded with status code %d: %s", response.StatusCode, content)
+	}
+
+	return decodeToken(content, MetadataProviderName)
+}
+
+// ProcessProvider provides the credentials printed by an external command.
+// The command must print a JSON object in the same format as the credential
+// proxy server, such as:
+//
+//	{"access_key": "ACCESS_KEY_ID", "secret_key": "SECRET_ACCESS_KEY",
+//	 "id_token": "", "expiration": 1530000000}
+//
+// The "id_token" and "expiration" fields are optional.
+type ProcessProvider struct {
+	Command string
+	Timeout time.Duration
+}
+
+// Retrieve runs the command and returns the credentials it prints.
+func (p *ProcessProvider) Retrieve(ctx context.Context) (Value, error) {
+	if p.Command == "" {
+		return Value{}, ErrNoCredentials
+	}
+
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.Command)
+	}
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	cmd.Env = os.Environ()
+
+	content, err := cmd.Output()
+	if err != nil {
+		return Value{}, fmt.Errorf(
+			"credential process failed: %s: %s", err.Error(), bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	return decodeToken(content, ProcessProviderName)
+}
+
+func decodeToken(content []byte, providerName string) (Value, error) {
+	token := &struct {
+		AccessKey    string `json:"access_key"`
+		SecretAccess string `json:"secret_key"`
+		Token        string `json:"id_token"`
+		Expiration   int64  `json:"expiration"`
+	}{}
+	_, err := utils.JSONDecode(content, token)
+	if err != nil {
+		return Value{}, fmt.Errorf("%s: decode credentials: %s", providerName, err.Error())
+	}
+
+	value := Value{
+		AccessKeyID:     token.AccessKey,
+		SecretAccessKey: token.SecretAccess,
+		Token:           token.Token,
+	}
+	if token.Expiration != 0 {
+		value.Expiration = time.Unix(token.Expiration, 0)
+	}
+	if value.AccessKeyID == "" || value.SecretAccessKey == "" {
+		return Value{}, fmt.Errorf("%s: access keys not returned", providerName)
+	}
+	value.ProviderName = providerName
+	return value, nil
+}
+
+func checkKeys(value Value, providerName string) (Value, error) {
+	if value.AccessKeyID == "" && value.SecretAccessKey == "" {
+		return Value{}, ErrNoCredentials
+	}
+	if value.AccessKeyID == "" {
+		return Value{}, errors.New("access key not provided")
+	}
+	if value.SecretAccessKey == "" {
+		return Value{}, errors.New("secret access key not provided")
+	}
+
+	value.ProviderName = providerName
+	return value, nil
+}
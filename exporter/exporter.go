@@ -0,0 +1,141 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+// Package exporter scrapes QingCloud monitor data of resources, and renders
+// it in the Prometheus text exposition format or as CSV.
+package exporter
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/client"
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+// Defaults of Exporter.
+const (
+	DefaultWindow = 30 * time.Minute
+)
+
+// A MonitorQuery is the query of a monitor API call of a resource.
+type MonitorQuery = client.MonitorQuery
+
+// MonitorFunc calls a monitor API, such as GetMonitor or GetRDBMonitor, and
+// returns the meters of the resource.
+type MonitorFunc = client.MonitorFunc
+
+// A Target is a set of resources in a zone scraped with the same monitor API
+// and meters.
+type Target struct {
+	Zone      string
+	Resources []string
+	Meters    []string
+	// Tags of the resources by resource ID, rendered as the tags label.
+	Tags    map[string][]string
+	Monitor MonitorFunc
+}
+
+// A Series is the decoded monitor data of a meter of a resource.
+type Series struct {
+	Zone       string
+	ResourceID string
+	MeterID    string
+	// VxNetID is set for per vxnet meters such as traffic.
+	VxNetID string
+	Tags    []string
+	Points  []service.Point
+}
+
+// An Exporter scrapes the monitor data of the last Window of its targets.
+// The resources of each target are queried by a client.MonitorClient, which
+// selects the step keeping the series within MaxPoints, splits the window
+// into chunks of MaxPointsPerRequest points and runs at most Concurrency
+// calls at the same time. The client defaults are used for the limits which
+// are not positive, and DefaultWindow is used if Window is not positive.
+type Exporter struct {
+	Targets             []*Target
+	Window              time.Duration
+	MaxPoints           int
+	MaxPointsPerRequest int
+	Concurrency         int
+
+	now func() time.Time
+}
+
+// New create an Exporter of the targets with the default window and limits.
+func New(targets ...*Target) *Exporter {
+	return &Exporter{
+		Targets:             targets,
+		Window:              DefaultWindow,
+		MaxPoints:           client.DefaultMonitorMaxPoints,
+		MaxPointsPerRequest: client.DefaultMonitorMaxPointsPerRequest,
+		Concurrency:         client.DefaultMonitorConcurrency,
+		now:                 time.Now,
+	}
+}
+
+// Scrape calls the monitor APIs of all the resources of the targets, and
+// returns the series sorted by zone, resource and meter.
+func (e *Exporter) Scrape(ctx context.Context) ([]*Series, error) {
+	now := e.now
+	if now == nil {
+		now = time.Now
+	}
+	window := e.Window
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	endTime := now()
+	startTime := endTime.Add(-window)
+
+	result := []*Series{}
+	for _, target := range e.Targets {
+		c := client.NewMonitorClientWithFunc(target.Monitor, e.MaxPoints, e.MaxPointsPerRequest, e.Concurrency)
+		monitorSeries, err := c.GetMonitor(ctx, target.Resources, target.Meters, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		for _, ms := range monitorSeries {
+			tags := append([]string{}, target.Tags[ms.ResourceID]...)
+			sort.Strings(tags)
+			result = append(result, &Series{
+				Zone:       target.Zone,
+				ResourceID: ms.ResourceID,
+				MeterID:    ms.MeterID,
+				VxNetID:    ms.VxNetID,
+				Tags:       tags,
+				Points:     ms.Points,
+			})
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Zone != b.Zone {
+			return a.Zone < b.Zone
+		}
+		if a.ResourceID != b.ResourceID {
+			return a.ResourceID < b.ResourceID
+		}
+		if a.MeterID != b.MeterID {
+			return a.MeterID < b.MeterID
+		}
+		return a.VxNetID < b.VxNetID
+	})
+	return result, nil
+}
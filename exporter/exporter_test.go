@@ -0,0 +1,204 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+var testNow = time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+
+func newTestExporter(monitor MonitorFunc) *Exporter {
+	e := New(&Target{
+		Zone:      "pek3a",
+		Resources: []string{"i-2", "i-1"},
+		Meters:    []string{"cpu", "disk-os"},
+		Tags:      map[string][]string{"i-1": {"web", "prod"}},
+		Monitor:   monitor,
+	})
+	e.now = func() time.Time { return testNow }
+	return e
+}
+
+func testMonitor(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+	start := float64(query.StartTime.Unix())
+	return []*service.Meter{
+		{MeterID: service.String("disk-os"), Data: []interface{}{
+			[]interface{}{start, []interface{}{1.0, 2.0}}, []interface{}{3.0, 4.0}}},
+		{MeterID: service.String("cpu"), Data: []interface{}{
+			[]interface{}{start, 10.0}, 20.0, nil}},
+	}, nil
+}
+
+func TestExporter_Scrape(t *testing.T) {
+	queries := []*MonitorQuery{}
+	e := newTestExporter(func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		queries = append(queries, query)
+		return testMonitor(ctx, query)
+	})
+	e.Concurrency = 1
+
+	series, err := e.Scrape(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, queries, 2)
+	assert.Equal(t, testNow.Add(-DefaultWindow), queries[0].StartTime)
+	assert.Equal(t, testNow, queries[0].EndTime)
+	assert.Equal(t, "5m", queries[0].Step)
+	assert.Equal(t, []string{"cpu", "disk-os"}, queries[0].Meters)
+
+	assert.Len(t, series, 4)
+	assert.Equal(t, "i-1", series[0].ResourceID)
+	assert.Equal(t, "cpu", series[0].MeterID)
+	assert.Equal(t, []string{"prod", "web"}, series[0].Tags)
+	assert.Equal(t, "disk-os", series[1].MeterID)
+	assert.Equal(t, "i-2", series[2].ResourceID)
+	assert.Empty(t, series[2].Tags)
+	assert.Len(t, series[0].Points, 3)
+}
+
+func TestExporter_ScrapeError(t *testing.T) {
+	e := newTestExporter(func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		return nil, errors.New("permission denied")
+	})
+	_, err := e.Scrape(context.Background())
+	assert.EqualError(t, err, "permission denied")
+
+}
+
+func TestExporter_ScrapeLiteral(t *testing.T) {
+	queries := []*MonitorQuery{}
+	e := &Exporter{Targets: []*Target{{
+		Zone:      "pek3a",
+		Resources: []string{"i-1"},
+		Meters:    []string{"cpu"},
+		Monitor: func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+			queries = append(queries, query)
+			return testMonitor(ctx, query)
+		},
+	}}}
+	series, err := e.Scrape(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, series, 2)
+	if assert.Len(t, queries, 1) {
+		assert.Equal(t, DefaultWindow, queries[0].EndTime.Sub(queries[0].StartTime))
+		assert.Equal(t, "5m", queries[0].Step)
+	}
+
+	// Long windows are queried with coarser steps in chunks.
+	queries = queries[:0]
+	e.Window = 7 * 24 * time.Hour
+	e.MaxPointsPerRequest = 300
+	e.Concurrency = 1
+	_, err = e.Scrape(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, queries, 3)
+	assert.Equal(t, "15m", queries[0].Step)
+}
+
+func TestWritePrometheus(t *testing.T) {
+	series := []*Series{
+		{Zone: "pek3a", ResourceID: "i-1", MeterID: "cpu", Tags: []string{"prod", "web"}, Points: []service.Point{
+			{Time: testNow, Values: []float64{10}},
+			{Time: testNow.Add(5 * time.Minute), Values: []float64{20.5}},
+			{Time: testNow.Add(10 * time.Minute), Values: []float64{math.NaN()}},
+		}},
+		{Zone: "pek3a", ResourceID: "i-1", MeterID: "disk-os", Points: []service.Point{
+			{Time: testNow, Values: []float64{1, 2}},
+		}},
+		{Zone: "pek3a", ResourceID: "rtr-1", MeterID: "traffic", VxNetID: "vxnet-\"1\"", Points: []service.Point{
+			{Time: testNow, Values: []float64{3}},
+		}},
+		{Zone: "pek3a", ResourceID: "i-2", MeterID: "cpu", Points: []service.Point{}},
+	}
+
+	buf := &bytes.Buffer{}
+	assert.Nil(t, WritePrometheus(buf, series))
+	assert.Equal(t, `# TYPE qingcloud_cpu gauge
+qingcloud_cpu{zone="pek3a",resource_id="i-1",meter_id="cpu",tags="prod,web"} 20.5 1577840700000
+# TYPE qingcloud_disk_os gauge
+qingcloud_disk_os{zone="pek3a",resource_id="i-1",meter_id="disk-os",index="0"} 1 1577840400000
+qingcloud_disk_os{zone="pek3a",resource_id="i-1",meter_id="disk-os",index="1"} 2 1577840400000
+# TYPE qingcloud_traffic gauge
+qingcloud_traffic{zone="pek3a",resource_id="rtr-1",meter_id="traffic",vxnet_id="vxnet-\"1\""} 3 1577840400000
+`, buf.String())
+}
+
+func TestWriteCSV(t *testing.T) {
+	series := []*Series{
+		{Zone: "pek3a", ResourceID: "i-1", MeterID: "cpu", Tags: []string{"prod", "web"}, Points: []service.Point{
+			{Time: testNow, Values: []float64{10}},
+			{Time: testNow.Add(5 * time.Minute), Values: []float64{math.NaN()}},
+		}},
+		{Zone: "pek3a", ResourceID: "i-1", MeterID: "disk-os", Points: []service.Point{
+			{Time: testNow, Values: []float64{1, 2.5}},
+		}},
+	}
+
+	buf := &bytes.Buffer{}
+	assert.Nil(t, WriteCSV(buf, series))
+	assert.Equal(t, `time,zone,resource_id,meter_id,vxnet_id,tags,index,value
+2020-01-01T01:00:00Z,pek3a,i-1,cpu,,"prod,web",0,10
+2020-01-01T01:05:00Z,pek3a,i-1,cpu,,"prod,web",0,
+2020-01-01T01:00:00Z,pek3a,i-1,disk-os,,,0,1
+2020-01-01T01:00:00Z,pek3a,i-1,disk-os,,,1,2.5
+`, buf.String())
+}
+
+func TestExporter_ServeHTTP(t *testing.T) {
+	e := newTestExporter(testMonitor)
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, PrometheusContentType, recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(),
+		`qingcloud_cpu{zone="pek3a",resource_id="i-1",meter_id="cpu",tags="prod,web"} 20 1577838900000`)
+
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics?format=csv", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, CSVContentType, recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), "2020-01-01T00:30:00Z,pek3a,i-2,disk-os,,,1,2\n")
+
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics?format=json", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	e.Targets[0].Monitor = func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		return nil, errors.New("permission denied")
+	}
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusBadGateway, recorder.Code)
+}
+
+func TestMetricName(t *testing.T) {
+	assert.Equal(t, "qingcloud_cpu", MetricName("cpu"))
+	assert.Equal(t, "qingcloud_disk_os", MetricName("disk-os"))
+	assert.Equal(t, "qingcloud_if_traffic_vxnet_0", MetricName("if.traffic/vxnet-0"))
+}
@@ -0,0 +1,153 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package exporter
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+// MetricPrefix is the prefix of the names of Prometheus metrics.
+const MetricPrefix = "qingcloud_"
+
+// CSVHeader is the header row of CSV output.
+var CSVHeader = []string{"time", "zone", "resource_id", "meter_id", "vxnet_id", "tags", "index", "value"}
+
+// MetricName returns the Prometheus metric name of the meter, characters
+// not allowed in metric names are replaced by underscores.
+func MetricName(meterID string) string {
+	name := []byte(MetricPrefix + meterID)
+	for i, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == ':') {
+			name[i] = '_'
+		}
+	}
+	return string(name)
+}
+
+// WritePrometheus writes the latest point of the series in the Prometheus
+// text exposition format, values of multi-value meters are told apart by
+// the index label. Series without any value are skipped.
+func WritePrometheus(w io.Writer, series []*Series) error {
+	metrics := map[string][]string{}
+	for _, s := range series {
+		point, ok := latestPoint(s)
+		if !ok {
+			continue
+		}
+		name := MetricName(s.MeterID)
+		timestamp := point.Time.UnixNano() / int64(time.Millisecond)
+		for index, value := range point.Values {
+			if math.IsNaN(value) {
+				continue
+			}
+			labels := seriesLabels(s)
+			if len(point.Values) > 1 {
+				labels = append(labels, label("index", strconv.Itoa(index)))
+			}
+			metrics[name] = append(metrics[name], fmt.Sprintf("%s{%s} %s %d",
+				name, strings.Join(labels, ","), formatValue(value), timestamp))
+		}
+	}
+
+	names := []string{}
+	for name := range metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	writer := bufio.NewWriter(w)
+	for _, name := range names {
+		fmt.Fprintf(writer, "# TYPE %s gauge\n", name)
+		for _, line := range metrics[name] {
+			fmt.Fprintln(writer, line)
+		}
+	}
+	return writer.Flush()
+}
+
+// WriteCSV writes all the points of the series as CSV with CSVHeader, one
+// row per value. Missing values are empty.
+func WriteCSV(w io.Writer, series []*Series) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, s := range series {
+		tags := strings.Join(s.Tags, ",")
+		for _, point := range s.Points {
+			timestamp := point.Time.UTC().Format(time.RFC3339)
+			for index, value := range point.Values {
+				record := []string{timestamp, s.Zone, s.ResourceID, s.MeterID, s.VxNetID, tags, strconv.Itoa(index), ""}
+				if !math.IsNaN(value) {
+					record[len(record)-1] = formatValue(value)
+				}
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// latestPoint returns the latest point with any value of the series.
+func latestPoint(s *Series) (service.Point, bool) {
+	for i := len(s.Points) - 1; i >= 0; i-- {
+		for _, value := range s.Points[i].Values {
+			if !math.IsNaN(value) {
+				return s.Points[i], true
+			}
+		}
+	}
+	return service.Point{}, false
+}
+
+func seriesLabels(s *Series) []string {
+	labels := []string{
+		label("zone", s.Zone),
+		label("resource_id", s.ResourceID),
+		label("meter_id", s.MeterID),
+	}
+	if s.VxNetID != "" {
+		labels = append(labels, label("vxnet_id", s.VxNetID))
+	}
+	if len(s.Tags) > 0 {
+		labels = append(labels, label("tags", strings.Join(s.Tags, ",")))
+	}
+	return labels
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func label(name, value string) string {
+	return name + `="` + labelValueReplacer.Replace(value) + `"`
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
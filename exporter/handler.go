@@ -0,0 +1,56 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package exporter
+
+import (
+	"net/http"
+
+	"github.com/yunify/qingcloud-sdk-go/logger"
+)
+
+// Content types of the responses of Exporter.
+const (
+	PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+	CSVContentType        = "text/csv; charset=utf-8"
+)
+
+// ServeHTTP scrapes the targets on each request, and responds in the
+// Prometheus text exposition format, or as CSV if the format query
+// parameter is "csv".
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	series, err := e.Scrape(r.Context())
+	if err != nil {
+		logger.Error("Scrape monitor data error : [%s]", err.Error())
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	switch r.URL.Query().Get("format") {
+	case "csv":
+		w.Header().Set("Content-Type", CSVContentType)
+		err = WriteCSV(w, series)
+	case "", "prometheus":
+		w.Header().Set("Content-Type", PrometheusContentType)
+		err = WritePrometheus(w, series)
+	default:
+		http.Error(w, "unknown format \""+r.URL.Query().Get("format")+"\"", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		logger.Error("Write monitor data error : [%s]", err.Error())
+	}
+}
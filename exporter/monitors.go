@@ -0,0 +1,121 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package exporter
+
+import (
+	"context"
+
+	"github.com/yunify/qingcloud-sdk-go/client"
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+// NewMonitorFunc returns a MonitorFunc calling GetMonitor, which serves
+// instances, volumes, EIPs and other resources.
+func NewMonitorFunc(s *service.MonitorService) MonitorFunc {
+	return client.NewGetMonitorFunc(s)
+}
+
+// NewLoadBalancerMonitorFunc returns a MonitorFunc calling
+// GetLoadBalancerMonitor with the given resource type, such as
+// "loadbalancer" or "listener".
+func NewLoadBalancerMonitorFunc(s *service.LoadBalancerService, resourceType string) MonitorFunc {
+	return func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		output, err := s.GetLoadBalancerMonitorWithContext(ctx, &service.GetLoadBalancerMonitorInput{
+			Resource:     service.String(query.Resource),
+			ResourceType: service.String(resourceType),
+			Meters:       service.StringSlice(query.Meters),
+			StartTime:    service.Time(query.StartTime),
+			EndTime:      service.Time(query.EndTime),
+			Step:         service.String(query.Step),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.MeterSet, nil
+	}
+}
+
+// NewCacheMonitorFunc returns a MonitorFunc calling GetCacheMonitor.
+func NewCacheMonitorFunc(s *service.CacheService) MonitorFunc {
+	return func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		output, err := s.GetCacheMonitorWithContext(ctx, &service.GetCacheMonitorInput{
+			Resource:  service.String(query.Resource),
+			Meters:    service.StringSlice(query.Meters),
+			StartTime: service.Time(query.StartTime),
+			EndTime:   service.Time(query.EndTime),
+			Step:      service.String(query.Step),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.MeterSet, nil
+	}
+}
+
+// NewMongoMonitorFunc returns a MonitorFunc calling GetMongoMonitor.
+func NewMongoMonitorFunc(s *service.MongoService) MonitorFunc {
+	return func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		output, err := s.GetMongoMonitorWithContext(ctx, &service.GetMongoMonitorInput{
+			Resource:  service.String(query.Resource),
+			Meters:    service.StringSlice(query.Meters),
+			StartTime: service.Time(query.StartTime),
+			EndTime:   service.Time(query.EndTime),
+			Step:      service.String(query.Step),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.MeterSet, nil
+	}
+}
+
+// NewRDBMonitorFunc returns a MonitorFunc calling GetRDBMonitor with the
+// given engine, such as "mysql", and role, such as "master".
+func NewRDBMonitorFunc(s *service.RDBService, engine, role string) MonitorFunc {
+	return func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		output, err := s.GetRDBMonitorWithContext(ctx, &service.GetRDBMonitorInput{
+			Resource:  service.String(query.Resource),
+			RDBEngine: service.String(engine),
+			Role:      service.String(role),
+			Meters:    service.StringSlice(query.Meters),
+			StartTime: service.Time(query.StartTime),
+			EndTime:   service.Time(query.EndTime),
+			Step:      service.String(query.Step),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.MeterSet, nil
+	}
+}
+
+// NewRouterMonitorFunc returns a MonitorFunc calling GetRouterMonitor.
+func NewRouterMonitorFunc(s *service.RouterService) MonitorFunc {
+	return func(ctx context.Context, query *MonitorQuery) ([]*service.Meter, error) {
+		output, err := s.GetRouterMonitorWithContext(ctx, &service.GetRouterMonitorInput{
+			Resource:  service.String(query.Resource),
+			Meters:    service.StringSlice(query.Meters),
+			StartTime: service.Time(query.StartTime),
+			EndTime:   service.Time(query.EndTime),
+			Step:      service.String(query.Step),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.MeterSet, nil
+	}
+}
@@ -0,0 +1,263 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+// Package informer keeps a local cache of QingCloud resources up to date by
+// listing them periodically, and emits the changes as events.
+package informer
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/logger"
+)
+
+// EventType is the type of a resource change.
+type EventType string
+
+// Event types.
+const (
+	Added   EventType = "Added"
+	Updated EventType = "Updated"
+	Deleted EventType = "Deleted"
+)
+
+// An Event is a change of a resource found by the informer.
+// OldResource is set for Updated events.
+type Event struct {
+	Type        EventType
+	Resource    Resource
+	OldResource Resource
+}
+
+// A Resource is a cached resource with the fields used for indexing and
+// diffing, Object is the described resource such as *service.Instance.
+type Resource struct {
+	ID               string
+	Status           string
+	TransitionStatus string
+	StatusTime       time.Time
+	TagIDs           []string
+	Object           interface{}
+}
+
+// changed returns whether the resource is updated since old.
+func (r Resource) changed(old Resource) bool {
+	return r.Status != old.Status ||
+		r.TransitionStatus != old.TransitionStatus ||
+		!r.StatusTime.Equal(old.StatusTime)
+}
+
+// ListFunc lists all the resources to cache.
+type ListFunc func(ctx context.Context) ([]Resource, error)
+
+// An Informer lists resources every Interval, and keeps them in a cache
+// indexed by ID, tag and status.
+type Informer struct {
+	List     ListFunc
+	Interval time.Duration
+
+	mutex       sync.RWMutex
+	resources   map[string]Resource
+	tagIndex    map[string]map[string]bool
+	statusIndex map[string]map[string]bool
+	synced      bool
+	subscribers []chan Event
+}
+
+// New create an Informer listing resources by list every interval.
+func New(list ListFunc, interval time.Duration) *Informer {
+	return &Informer{
+		List:        list,
+		Interval:    interval,
+		resources:   map[string]Resource{},
+		tagIndex:    map[string]map[string]bool{},
+		statusIndex: map[string]map[string]bool{},
+	}
+}
+
+// Subscribe returns a channel receiving the events found after this call.
+// Events are sent in the goroutine of Run, which waits for every subscriber,
+// so the channel should be drained. It's closed when Run returns.
+func (i *Informer) Subscribe(buffer int) <-chan Event {
+	ch := make(chan Event, buffer)
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.subscribers = append(i.subscribers, ch)
+	return ch
+}
+
+// Run resyncs the cache immediately and then every Interval until the
+// context is done. Listing errors are logged and retried in next interval.
+func (i *Informer) Run(ctx context.Context) error {
+	defer i.closeSubscribers()
+
+	ticker := time.NewTicker(i.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := i.Resync(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Warn("Informer resync error : [%s]", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Resync lists the resources once, updates the cache and sends the events.
+func (i *Informer) Resync(ctx context.Context) error {
+	resources, err := i.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	events := i.replace(resources)
+
+	i.mutex.RLock()
+	subscribers := i.subscribers
+	i.mutex.RUnlock()
+
+	for _, event := range events {
+		for _, subscriber := range subscribers {
+			select {
+			case subscriber <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// HasSynced returns whether the cache has been filled by a resync.
+func (i *Informer) HasSynced() bool {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.synced
+}
+
+// Get returns the cached resource with the given ID.
+func (i *Informer) Get(id string) (Resource, bool) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	resource, ok := i.resources[id]
+	return resource, ok
+}
+
+// Resources returns all the cached resources sorted by ID.
+func (i *Informer) Resources() []Resource {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	ids := map[string]bool{}
+	for id := range i.resources {
+		ids[id] = true
+	}
+	return i.sortedResources(ids)
+}
+
+// ByTag returns the cached resources with the given tag ID sorted by ID.
+func (i *Informer) ByTag(tagID string) []Resource {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.sortedResources(i.tagIndex[tagID])
+}
+
+// ByStatus returns the cached resources in the given status sorted by ID.
+func (i *Informer) ByStatus(status string) []Resource {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.sortedResources(i.statusIndex[status])
+}
+
+func (i *Informer) sortedResources(ids map[string]bool) []Resource {
+	resources := []Resource{}
+	for id := range ids {
+		resources = append(resources, i.resources[id])
+	}
+	sort.Slice(resources, func(a, b int) bool {
+		return resources[a].ID < resources[b].ID
+	})
+	return resources
+}
+
+// replace replaces the cache with the listed resources and returns the
+// events of the differences.
+func (i *Informer) replace(resources []Resource) []Event {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	events := []Event{}
+	listed := map[string]Resource{}
+	for _, resource := range resources {
+		listed[resource.ID] = resource
+
+		old, ok := i.resources[resource.ID]
+		if !ok {
+			events = append(events, Event{Type: Added, Resource: resource})
+		} else if resource.changed(old) {
+			events = append(events, Event{Type: Updated, Resource: resource, OldResource: old})
+		}
+	}
+	for id, old := range i.resources {
+		if _, ok := listed[id]; !ok {
+			events = append(events, Event{Type: Deleted, Resource: old})
+		}
+	}
+
+	i.resources = listed
+	i.tagIndex = map[string]map[string]bool{}
+	i.statusIndex = map[string]map[string]bool{}
+	for id, resource := range listed {
+		for _, tagID := range resource.TagIDs {
+			addIndex(i.tagIndex, tagID, id)
+		}
+		addIndex(i.statusIndex, resource.Status, id)
+	}
+	i.synced = true
+
+	return events
+}
+
+func (i *Informer) closeSubscribers() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for _, subscriber := range i.subscribers {
+		close(subscriber)
+	}
+	i.subscribers = nil
+}
+
+func addIndex(index map[string]map[string]bool, key, id string) {
+	if index[key] == nil {
+		index[key] = map[string]bool{}
+	}
+	index[key][id] = true
+}
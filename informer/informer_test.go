@@ -0,0 +1,93 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package informer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInformer_Resync(t *testing.T) {
+	now := time.Now()
+	lists := [][]Resource{
+		{
+			{ID: "i-1", Status: "pending", TransitionStatus: "creating", StatusTime: now, TagIDs: []string{"tag-1"}},
+			{ID: "i-2", Status: "running", StatusTime: now},
+		},
+		{
+			{ID: "i-1", Status: "running", StatusTime: now.Add(time.Minute), TagIDs: []string{"tag-1"}},
+			{ID: "i-3", Status: "running", StatusTime: now},
+		},
+	}
+	informer := New(func(ctx context.Context) ([]Resource, error) {
+		resources := lists[0]
+		lists = lists[1:]
+		return resources, nil
+	}, time.Minute)
+	events := informer.Subscribe(10)
+	assert.False(t, informer.HasSynced())
+
+	assert.Nil(t, informer.Resync(context.Background()))
+	assert.True(t, informer.HasSynced())
+	assert.Equal(t, Added, (<-events).Type)
+	assert.Equal(t, Added, (<-events).Type)
+	assert.Equal(t, 1, len(informer.ByStatus("pending")))
+	assert.Equal(t, "i-1", informer.ByTag("tag-1")[0].ID)
+
+	assert.Nil(t, informer.Resync(context.Background()))
+	event := <-events
+	assert.Equal(t, Updated, event.Type)
+	assert.Equal(t, "running", event.Resource.Status)
+	assert.Equal(t, "pending", event.OldResource.Status)
+	event = <-events
+	assert.Equal(t, Added, event.Type)
+	assert.Equal(t, "i-3", event.Resource.ID)
+	event = <-events
+	assert.Equal(t, Deleted, event.Type)
+	assert.Equal(t, "i-2", event.Resource.ID)
+
+	_, ok := informer.Get("i-2")
+	assert.False(t, ok)
+	assert.Equal(t, 0, len(informer.ByStatus("pending")))
+	assert.Equal(t, 2, len(informer.ByStatus("running")))
+	assert.Equal(t, []string{"i-1", "i-3"}, []string{informer.Resources()[0].ID, informer.Resources()[1].ID})
+}
+
+func TestInformer_Run(t *testing.T) {
+	informer := New(func(ctx context.Context) ([]Resource, error) {
+		return []Resource{{ID: "vol-1", Status: "available"}}, nil
+	}, 10*time.Millisecond)
+	events := informer.Subscribe(0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- informer.Run(ctx)
+	}()
+
+	event := <-events
+	assert.Equal(t, Added, event.Type)
+	assert.Equal(t, "vol-1", event.Resource.ID)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+	_, ok := <-events
+	assert.False(t, ok)
+}
@@ -0,0 +1,106 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package informer
+
+import (
+	"context"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/service"
+)
+
+// NewInstanceInformer create an Informer of the instances described by
+// input, the Object of resources is *service.Instance.
+func NewInstanceInformer(s *service.InstanceService, input *service.DescribeInstancesInput, interval time.Duration) *Informer {
+	return New(func(ctx context.Context) ([]Resource, error) {
+		instances, err := s.DescribeInstancesAll(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []Resource{}
+		for _, instance := range instances {
+			resources = append(resources, Resource{
+				ID:               service.StringValue(instance.InstanceID),
+				Status:           service.StringValue(instance.Status),
+				TransitionStatus: service.StringValue(instance.TransitionStatus),
+				StatusTime:       service.TimeValue(instance.StatusTime),
+				TagIDs:           tagIDs(instance.Tags),
+				Object:           instance,
+			})
+		}
+		return resources, nil
+	}, interval)
+}
+
+// NewLoadBalancerInformer create an Informer of the load balancers described
+// by input, the Object of resources is *service.LoadBalancer.
+func NewLoadBalancerInformer(s *service.LoadBalancerService, input *service.DescribeLoadBalancersInput, interval time.Duration) *Informer {
+	return New(func(ctx context.Context) ([]Resource, error) {
+		loadBalancers, err := s.DescribeLoadBalancersAll(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []Resource{}
+		for _, loadBalancer := range loadBalancers {
+			resources = append(resources, Resource{
+				ID:               service.StringValue(loadBalancer.LoadBalancerID),
+				Status:           service.StringValue(loadBalancer.Status),
+				TransitionStatus: service.StringValue(loadBalancer.TransitionStatus),
+				StatusTime:       service.TimeValue(loadBalancer.StatusTime),
+				TagIDs:           tagIDs(loadBalancer.Tags),
+				Object:           loadBalancer,
+			})
+		}
+		return resources, nil
+	}, interval)
+}
+
+// NewVolumeInformer create an Informer of the volumes described by input,
+// the Object of resources is *service.Volume.
+func NewVolumeInformer(s *service.VolumeService, input *service.DescribeVolumesInput, interval time.Duration) *Informer {
+	return New(func(ctx context.Context) ([]Resource, error) {
+		volumes, err := s.DescribeVolumesAll(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		resources := []Resource{}
+		for _, volume := range volumes {
+			resources = append(resources, Resource{
+				ID:               service.StringValue(volume.VolumeID),
+				Status:           service.StringValue(volume.Status),
+				TransitionStatus: service.StringValue(volume.TransitionStatus),
+				StatusTime:       service.TimeValue(volume.StatusTime),
+				TagIDs:           tagIDs(volume.Tags),
+				Object:           volume,
+			})
+		}
+		return resources, nil
+	}, interval)
+}
+
+func tagIDs(tags []*service.Tag) []string {
+	ids := []string{}
+	for _, tag := range tags {
+		if tag != nil && tag.TagID != nil {
+			ids = append(ids, *tag.TagID)
+		}
+	}
+	return ids
+}
@@ -66,16 +66,18 @@ func CheckLevel(level string) error {
 
 // GetLevel get the log level string.
 func GetLevel() string {
-	return instance.Level.String()
+	return instance.GetLevel().String()
 }
 
 // SetLevel sets the log level. Valid levels are "debug", "info", "warn", "error", and "fatal".
-func SetLevel(level string) {
+// It returns error and keeps the current level if the level is not valid.
+func SetLevel(level string) error {
 	lvl, err := logrus.ParseLevel(level)
 	if err != nil {
-		Fatal(fmt.Sprintf(`log level not valid: "%s"`, level))
+		return fmt.Errorf(`log level not valid: "%s"`, level)
 	}
-	instance.Level = lvl
+	instance.SetLevel(lvl)
+	return nil
 }
 
 // Debug logs a message with severity DEBUG.
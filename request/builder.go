@@ -24,7 +24,6 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/yunify/qingcloud-sdk-go/logger"
 	"github.com/yunify/qingcloud-sdk-go/request/data"
@@ -33,6 +32,10 @@ import (
 
 // Builder is the request builder for QingCloud service.
 type Builder struct {
+	// Token is the IAM token of temporary credentials, the request is sent
+	// to "/iam" URI with the token if it's set.
+	Token string
+
 	parsedURL        string
 	parsedForm       url.Values
 	parsedProperties *map[string]string
@@ -130,7 +133,9 @@ func (b *Builder) parseRequestParams() error {
 
 	requestParams["action"] = b.operation.APIName
 
-	if b.operation.Config.URI == "/iam" {
+	if b.Token != "" {
+		requestParams["token"] = b.Token
+	} else if b.operation.Config.URI == "/iam" {
 		requestParams["token"] = b.operation.Config.Token
 	}
 
@@ -138,97 +143,12 @@ func (b *Builder) parseRequestParams() error {
 		return nil
 	}
 
-	for i := 0; i < b.input.Elem().NumField(); i++ {
-		tagName := b.input.Elem().Type().Field(i).Tag.Get("name")
-		tagLocation := b.input.Elem().Type().Field(i).Tag.Get("location")
-		tagDefault := b.input.Elem().Type().Field(i).Tag.Get("default")
-		if tagName != "" && tagLocation != "" && requestParams != nil {
-			switch value := b.input.Elem().Field(i).Interface().(type) {
-			case *string:
-				if tagDefault != "" {
-					requestParams[tagName] = tagDefault
-				}
-				if value != nil {
-					requestParams[tagName] = *value
-				}
-			case *int:
-				if tagDefault != "" {
-					requestParams[tagName] = tagDefault
-				}
-				if value != nil {
-					requestParams[tagName] = strconv.Itoa(int(*value))
-				}
-			case *bool:
-			case *time.Time:
-				if tagDefault != "" {
-					requestParams[tagName] = tagDefault
-				}
-				if value != nil {
-					format := b.input.Elem().Type().Field(i).Tag.Get("format")
-					requestParams[tagName] = utils.TimeToString(*value, format)
-				}
-			case []*string:
-				for index, item := range value {
-					key := tagName + "." + strconv.Itoa(index+1)
-					if tagDefault != "" {
-						requestParams[tagName] = tagDefault
-					}
-					if item != nil {
-						requestParams[key] = *item
-					}
-				}
-			case []*int:
-				for index, item := range value {
-					key := tagName + "." + strconv.Itoa(index+1)
-					if tagDefault != "" {
-						requestParams[tagName] = tagDefault
-					}
-					if item != nil {
-						requestParams[key] = strconv.Itoa(int(*item))
-					}
-				}
-			default:
-				if value != nil {
-					value = value.(interface{})
-					typeName := reflect.TypeOf(value.(interface{})).String()
-
-					if strings.HasPrefix(typeName, "[]*") {
-						valueArray := reflect.ValueOf(value)
-
-						for i := 0; i < valueArray.Len(); i++ {
-							item := valueArray.Index(i).Elem()
-
-							for j := 0; j < item.NumField(); j++ {
-								fieldTagName := item.Type().Field(j).Tag.Get("name")
-								tagKey := tagName + "." + strconv.Itoa(i+1) + "." + fieldTagName
-
-								switch fieldValue := item.Field(j).Interface().(type) {
-								case *int:
-									if fieldValue != nil {
-										requestParams[tagKey] = strconv.Itoa(int(*fieldValue))
-									}
-								case *string:
-									if fieldValue != nil {
-										requestParams[tagKey] = *fieldValue
-									}
-								case []*string:
-									dst := make([]string, len(fieldValue))
-									for i := 0; i < len(fieldValue); i++ {
-										if fieldValue[i] != nil {
-											dst[i] = *(fieldValue[i])
-										}
-									}
-									if len(dst) != 0 {
-										requestParams[tagKey] = strings.Join(dst, ",")
-									}
-
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+	params, err := EncodeParams(b.input.Interface())
+	if err != nil {
+		return err
+	}
+	for key, value := range params {
+		requestParams[key] = value
 	}
 
 	return nil
@@ -238,7 +158,11 @@ func (b *Builder) parseRequestURL() error {
 	conf := b.operation.Config
 
 	endpoint := conf.Protocol + "://" + conf.Host + ":" + strconv.Itoa(conf.Port)
-	requestURI := regexp.MustCompile(`/+`).ReplaceAllString(conf.URI, "/")
+	uri := conf.URI
+	if b.Token != "" {
+		uri = "/iam"
+	}
+	requestURI := regexp.MustCompile(`/+`).ReplaceAllString(uri, "/")
 
 	b.parsedURL = endpoint + requestURI
 
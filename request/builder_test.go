@@ -63,6 +63,14 @@ func Int(v int) *int {
 	return &v
 }
 
+func Bool(v bool) *bool {
+	return &v
+}
+
+func Float64(v float64) *float64 {
+	return &v
+}
+
 func TestBuilder(t *testing.T) {
 
 	conf, err := config.NewDefault()
@@ -51,3 +51,26 @@ func (e ParameterValueNotAllowedError) Error() string {
 		e.ParameterValue,
 		strings.Join(allowedValues, ", "))
 }
+
+// ParameterValueInvalidError indicates that the parameter value is malformed or out of range.
+type ParameterValueInvalidError struct {
+	ParameterName  string
+	ParameterValue string
+	Reason         string
+}
+
+// Error returns the description of ParameterValueInvalidError.
+func (e ParameterValueInvalidError) Error() string {
+	return fmt.Sprintf(`"%s" value "%s" is not valid, %s`, e.ParameterName, e.ParameterValue, e.Reason)
+}
+
+// ParameterTypeNotSupportedError indicates that the parameter type can not be encoded.
+type ParameterTypeNotSupportedError struct {
+	ParameterName string
+	ParameterType string
+}
+
+// Error returns the description of ParameterTypeNotSupportedError.
+func (e ParameterTypeNotSupportedError) Error() string {
+	return fmt.Sprintf(`"%s" type "%s" is not supported`, e.ParameterName, e.ParameterType)
+}
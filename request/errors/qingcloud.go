@@ -17,16 +17,98 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 )
 
+// QingCloud ret codes returned in the response body.
+const (
+	RetCodeBadRequest           = 1100
+	RetCodeAuthFailure          = 1200
+	RetCodeRequestExpired       = 1300
+	RetCodePermissionDenied     = 1400
+	RetCodeTooManyRequests      = 1500
+	RetCodeResourceNotFound     = 2100
+	RetCodeBalanceInsufficient  = 2400
+	RetCodeQuotaExceeded        = 2500
+	RetCodeInternalError        = 5000
+	RetCodeServerBusy           = 5100
+	RetCodeResourceInsufficient = 5200
+	RetCodeServiceUpdating      = 5300
+)
+
+// The error classes of QingCloud errors, use errors.Is to check whether an
+// error belongs to one of them.
+var (
+	ErrAuthFailure      = errors.New("authentication failure")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrResourceNotFound = errors.New("resource not found")
+	ErrQuotaExceeded    = errors.New("quota exceeded")
+	ErrThrottled        = errors.New("throttled")
+	ErrResourceBusy     = errors.New("resource busy")
+	ErrInternalError    = errors.New("internal error")
+)
+
+// RetCodeClasses maps ret codes to the error classes they belong to.
+var RetCodeClasses = map[int]error{
+	RetCodeAuthFailure:          ErrAuthFailure,
+	RetCodeRequestExpired:       ErrAuthFailure,
+	RetCodePermissionDenied:     ErrPermissionDenied,
+	RetCodeTooManyRequests:      ErrThrottled,
+	RetCodeResourceNotFound:     ErrResourceNotFound,
+	RetCodeBalanceInsufficient:  ErrQuotaExceeded,
+	RetCodeQuotaExceeded:        ErrQuotaExceeded,
+	RetCodeInternalError:        ErrInternalError,
+	RetCodeServerBusy:           ErrResourceBusy,
+	RetCodeResourceInsufficient: ErrResourceBusy,
+	RetCodeServiceUpdating:      ErrResourceBusy,
+}
+
+// StatusCodeClasses maps HTTP status codes to the error classes they belong
+// to, it's used when the response carries no ret code.
+var StatusCodeClasses = map[int]error{
+	http.StatusUnauthorized:        ErrAuthFailure,
+	http.StatusForbidden:           ErrPermissionDenied,
+	http.StatusNotFound:            ErrResourceNotFound,
+	http.StatusTooManyRequests:     ErrThrottled,
+	http.StatusInternalServerError: ErrInternalError,
+	http.StatusBadGateway:          ErrInternalError,
+	http.StatusServiceUnavailable:  ErrResourceBusy,
+	http.StatusGatewayTimeout:      ErrInternalError,
+}
+
 // QingCloudError stores information of a QingCloud error response.
 type QingCloudError struct {
 	RetCode int    `json:"ret_code"`
 	Message string `json:"message"`
+
+	// Action is the name of the API which returned the error.
+	Action string `json:"-"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// Body is the raw body of the response.
+	Body []byte `json:"-"`
 }
 
 // Error returns the description of QingCloud error response.
 func (ise QingCloudError) Error() string {
+	if ise.RetCode == 0 && ise.StatusCode != 0 && ise.StatusCode != http.StatusOK {
+		return fmt.Sprintf("QingCloud Error: StatusCode (%d), Message (%s)", ise.StatusCode, ise.Message)
+	}
 	return fmt.Sprintf("QingCloud Error: Code (%d), Message (%s)", ise.RetCode, ise.Message)
 }
+
+// Class returns the error class of this error, or nil if it's unknown.
+func (ise QingCloudError) Class() error {
+	if ise.RetCode != 0 {
+		return RetCodeClasses[ise.RetCode]
+	}
+	return StatusCodeClasses[ise.StatusCode]
+}
+
+// Is reports whether this error belongs to the target error class.
+func (ise QingCloudError) Is(target error) bool {
+	class := ise.Class()
+	return class != nil && class == target
+}
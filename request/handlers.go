@@ -0,0 +1,206 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"github.com/yunify/qingcloud-sdk-go/config"
+)
+
+// A Handler is a named step of a request phase.
+// It returns error to stop the request.
+type Handler struct {
+	Name string
+	Fn   func(r *Request) error
+}
+
+// A HandlerList is an ordered list of handlers for a request phase.
+type HandlerList struct {
+	list []Handler
+}
+
+// Len returns the number of handlers in the list.
+func (l *HandlerList) Len() int {
+	return len(l.list)
+}
+
+// Copy returns a copy of this handler list.
+func (l *HandlerList) Copy() HandlerList {
+	n := HandlerList{}
+	if len(l.list) > 0 {
+		n.list = make([]Handler, len(l.list))
+		copy(n.list, l.list)
+	}
+	return n
+}
+
+// Clear removes all the handlers in the list.
+func (l *HandlerList) Clear() {
+	l.list = l.list[0:0]
+}
+
+// PushBack pushes handler f to the back of the list.
+func (l *HandlerList) PushBack(f func(r *Request) error) {
+	l.PushBackNamed(Handler{Name: "__anonymous", Fn: f})
+}
+
+// PushBackNamed pushes named handler h to the back of the list.
+func (l *HandlerList) PushBackNamed(h Handler) {
+	l.list = append(l.list, h)
+}
+
+// PushFront pushes handler f to the front of the list.
+func (l *HandlerList) PushFront(f func(r *Request) error) {
+	l.PushFrontNamed(Handler{Name: "__anonymous", Fn: f})
+}
+
+// PushFrontNamed pushes named handler h to the front of the list.
+func (l *HandlerList) PushFrontNamed(h Handler) {
+	l.list = append([]Handler{h}, l.list...)
+}
+
+// Remove removes all the handlers with the given name.
+func (l *HandlerList) Remove(name string) {
+	list := l.list[0:0]
+	for _, h := range l.list {
+		if h.Name != name {
+			list = append(list, h)
+		}
+	}
+	l.list = list
+}
+
+// Swap replaces the handlers with the given name by h.
+// It returns false if no handler was replaced.
+func (l *HandlerList) Swap(name string, h Handler) bool {
+	swapped := false
+	for i := range l.list {
+		if l.list[i].Name == name {
+			l.list[i] = h
+			swapped = true
+		}
+	}
+	return swapped
+}
+
+// Run runs the handlers in order, it stops at the first error.
+func (l *HandlerList) Run(r *Request) error {
+	for _, h := range l.list {
+		if err := h.Fn(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handlers stores the handler lists of every request phase.
+//
+// Build and Sign run before each attempt, Send and Unpack run for each
+// attempt and the failed attempts are retried by the configured Retryer.
+// Complete runs once the request finished, with Request.Error set.
+type Handlers struct {
+	Build    HandlerList
+	Sign     HandlerList
+	Send     HandlerList
+	Unpack   HandlerList
+	Complete HandlerList
+}
+
+// Copy returns a copy of these handlers, it returns nil if h is nil.
+func (h *Handlers) Copy() *Handlers {
+	if h == nil {
+		return nil
+	}
+	return &Handlers{
+		Build:    h.Build.Copy(),
+		Sign:     h.Sign.Copy(),
+		Send:     h.Send.Copy(),
+		Unpack:   h.Unpack.Copy(),
+		Complete: h.Complete.Copy(),
+	}
+}
+
+// Merge appends the handlers of o to the lists of h, it does nothing if o is nil.
+func (h *Handlers) Merge(o *Handlers) {
+	if o == nil {
+		return
+	}
+	h.Build.list = append(h.Build.list, o.Build.list...)
+	h.Sign.list = append(h.Sign.list, o.Sign.list...)
+	h.Send.list = append(h.Send.list, o.Send.list...)
+	h.Unpack.list = append(h.Unpack.list, o.Unpack.list...)
+	h.Complete.list = append(h.Complete.list, o.Complete.list...)
+}
+
+// ConfigHandlers returns the handlers set in Handlers of the Config,
+// it returns nil if there is none. Handlers pushed to them run for every
+// request made with the Config, by all the services sharing it:
+//
+//	h := &request.Handlers{}
+//	h.Build.PushBack(addHeader)
+//	c.Handlers = h
+func ConfigHandlers(c *config.Config) *Handlers {
+	if c == nil {
+		return nil
+	}
+	h, _ := c.Handlers.(*Handlers)
+	return h
+}
+
+// Clear removes all the handlers.
+func (h *Handlers) Clear() {
+	h.Build.Clear()
+	h.Sign.Clear()
+	h.Send.Clear()
+	h.Unpack.Clear()
+	h.Complete.Clear()
+}
+
+// CheckCredentialsHandler makes sure the credentials are available.
+var CheckCredentialsHandler = Handler{Name: "qingcloud.CheckCredentialsHandler", Fn: func(r *Request) error {
+	return r.check(r.Context())
+}}
+
+// BuildHandler builds the HTTP request from the input.
+var BuildHandler = Handler{Name: "qingcloud.BuildHandler", Fn: func(r *Request) error {
+	return r.build(r.Context())
+}}
+
+// SignHandler signs the HTTP request with the access key.
+var SignHandler = Handler{Name: "qingcloud.SignHandler", Fn: func(r *Request) error {
+	return r.sign()
+}}
+
+// SendHandler sends the HTTP request with the configured connection.
+var SendHandler = Handler{Name: "qingcloud.SendHandler", Fn: func(r *Request) error {
+	return r.send(r.Context())
+}}
+
+// UnpackHandler unpacks the HTTP response into the output.
+var UnpackHandler = Handler{Name: "qingcloud.UnpackHandler", Fn: func(r *Request) error {
+	return r.unpack()
+}}
+
+// DefaultHandlers returns the handlers used to send QingCloud API requests.
+func DefaultHandlers() *Handlers {
+	h := &Handlers{}
+	h.Build.PushBackNamed(CheckCredentialsHandler)
+	h.Build.PushBackNamed(BuildHandler)
+	h.Sign.PushBackNamed(SignHandler)
+	h.Send.PushBackNamed(SendHandler)
+	h.Unpack.PushBackNamed(UnpackHandler)
+	return h
+}
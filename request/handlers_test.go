@@ -0,0 +1,94 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandlerList(t *testing.T) {
+	order := []string{}
+	record := func(name string) Handler {
+		return Handler{Name: name, Fn: func(r *Request) error {
+			order = append(order, name)
+			return nil
+		}}
+	}
+
+	l := HandlerList{}
+	l.PushBackNamed(record("b"))
+	l.PushBackNamed(record("c"))
+	l.PushFrontNamed(record("a"))
+	assert.Equal(t, 3, l.Len())
+
+	c := l.Copy()
+	c.Remove("b")
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, 3, l.Len())
+
+	assert.True(t, l.Swap("c", record("d")))
+	assert.False(t, l.Swap("x", record("x")))
+
+	assert.Nil(t, l.Run(&Request{}))
+	assert.Equal(t, []string{"a", "b", "d"}, order)
+
+	order = []string{}
+	l.PushFront(func(r *Request) error {
+		return errors.New("stop")
+	})
+	assert.NotNil(t, l.Run(&Request{}))
+	assert.Equal(t, []string{}, order)
+
+	l.Clear()
+	assert.Equal(t, 0, l.Len())
+}
+
+func TestRequest_SendWithCustomHandlers(t *testing.T) {
+	r := newTestRequest(t, "http://api.qc.dev:80")
+
+	r.Handlers.Build.PushBack(func(r *Request) error {
+		r.HTTPRequest.Header.Set("X-Request-Tag", "test")
+		return nil
+	})
+	r.Handlers.Send.Swap(SendHandler.Name, Handler{Name: "test.MockSend", Fn: func(r *Request) error {
+		assert.Equal(t, "test", r.HTTPRequest.Header.Get("X-Request-Tag"))
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body: ioutil.NopCloser(bytes.NewReader(
+				[]byte(`{"action":"DescribeInstancesResponse","ret_code":0}`))),
+		}
+		return nil
+	}})
+
+	completed := false
+	r.Handlers.Complete.PushBack(func(r *Request) error {
+		completed = true
+		assert.Nil(t, r.Error)
+		return nil
+	})
+
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.True(t, completed)
+}
@@ -0,0 +1,93 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"errors"
+)
+
+// ErrNoMorePages is returned by NextPage of paginators after the last page.
+var ErrNoMorePages = errors.New("no more pages")
+
+// DefaultPageLimit is the number of items in a page returned by Describe*
+// operations when the input has no limit.
+const DefaultPageLimit = 20
+
+// A Pagination tracks the offset of a paginated Describe* operation,
+// it's embedded in the generated paginators.
+type Pagination struct {
+	offset     int
+	limit      int
+	totalCount int
+	pages      int
+	done       bool
+}
+
+// NewPagination create a Pagination starting at the given offset, with the
+// given limit of each page. Both of them can be nil, DefaultPageLimit is
+// assumed if the limit is nil.
+func NewPagination(offset, limit *int) Pagination {
+	p := Pagination{limit: DefaultPageLimit, totalCount: -1}
+	if offset != nil {
+		p.offset = *offset
+	}
+	if limit != nil && *limit > 0 {
+		p.limit = *limit
+	}
+	return p
+}
+
+// HasMorePages returns whether there are more pages to fetch.
+func (p *Pagination) HasMorePages() bool {
+	return !p.done
+}
+
+// Offset returns the offset of the next page.
+func (p *Pagination) Offset() *int {
+	offset := p.offset
+	return &offset
+}
+
+// Pages returns the number of pages fetched.
+func (p *Pagination) Pages() int {
+	return p.pages
+}
+
+// TotalCount returns the total count in the last page, or -1 if it's
+// unknown.
+func (p *Pagination) TotalCount() int {
+	return p.totalCount
+}
+
+// Advance moves to the next page after a page of count items is fetched.
+// The pagination is done when the page is empty, the total count is
+// reached, or the page is smaller than the limit without a total count, so
+// no extra empty page is requested after a short page.
+func (p *Pagination) Advance(count int, totalCount *int) {
+	p.pages++
+	p.offset += count
+
+	switch {
+	case count == 0:
+		p.done = true
+	case totalCount != nil:
+		p.totalCount = *totalCount
+		p.done = p.offset >= p.totalCount
+	default:
+		p.done = count < p.limit
+	}
+}
@@ -0,0 +1,66 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagination(t *testing.T) {
+	p := NewPagination(Int(10), nil)
+	assert.True(t, p.HasMorePages())
+	assert.Equal(t, 10, *p.Offset())
+	assert.Equal(t, -1, p.TotalCount())
+
+	p.Advance(20, Int(45))
+	assert.True(t, p.HasMorePages())
+	assert.Equal(t, 30, *p.Offset())
+	assert.Equal(t, 45, p.TotalCount())
+
+	p.Advance(15, Int(45))
+	assert.False(t, p.HasMorePages())
+	assert.Equal(t, 2, p.Pages())
+
+	p = NewPagination(nil, Int(20))
+	p.Advance(20, nil)
+	assert.True(t, p.HasMorePages())
+	p.Advance(5, nil)
+	assert.False(t, p.HasMorePages())
+
+	p = NewPagination(nil, nil)
+	p.Advance(20, nil)
+	assert.True(t, p.HasMorePages())
+	p.Advance(0, nil)
+	assert.False(t, p.HasMorePages())
+
+	p = NewPagination(nil, nil)
+	p.Advance(DefaultPageLimit, nil)
+	assert.True(t, p.HasMorePages())
+	p.Advance(3, nil)
+	assert.False(t, p.HasMorePages())
+	assert.Equal(t, DefaultPageLimit+3, *p.Offset())
+
+	p = NewPagination(nil, nil)
+	p.Advance(DefaultPageLimit, Int(50))
+	assert.True(t, p.HasMorePages())
+	p.Advance(DefaultPageLimit, Int(50))
+	p.Advance(10, Int(50))
+	assert.False(t, p.HasMorePages())
+	assert.Equal(t, 3, p.Pages())
+}
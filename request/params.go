@@ -0,0 +1,432 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+	"github.com/yunify/qingcloud-sdk-go/utils"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// EncodeParams encodes an input struct into QingCloud request params.
+//
+// Only the fields tagged with both "name" and "location" are encoded, the
+// "name" tag is the param key. Nil pointers, nil interfaces and empty slices
+// are omitted, unless the field has a "default" tag whose value is used.
+// The values are encoded as follows:
+//
+//   - string, integer and float as their decimal text
+//   - bool as "1" or "0"
+//   - time.Time with the layout in the "format" tag, "ISO 8601" by default
+//   - slice elements as "name.1", "name.2" and so on, nil elements are
+//     skipped but keep their index
+//   - struct fields as "name.field" using the "name" tag of the field, so
+//     a slice of structs is encoded as "name.1.field", "name.2.field"
+//   - slices of scalars inside structs are joined with "," into one value
+//   - maps and interface values as JSON, except strings which are kept
+//
+// The "default" tag is ignored for the fields of nested structs. Other
+// types, such as channels and functions, are reported as
+// ParameterTypeNotSupportedError instead of being dropped.
+func EncodeParams(input interface{}) (map[string]string, error) {
+	params := map[string]string{}
+
+	v := reflect.ValueOf(input)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return params, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, errors.ParameterTypeNotSupportedError{
+			ParameterName: "input",
+			ParameterType: v.Type().String(),
+		}
+	}
+
+	err := encodeStruct(params, "", v, true)
+	if err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func encodeStruct(params map[string]string, prefix string, v reflect.Value, top bool) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := field.Tag.Get("name")
+		if name == "" || field.PkgPath != "" {
+			continue
+		}
+		if top && field.Tag.Get("location") == "" {
+			continue
+		}
+
+		key := prefix + name
+		if top && isEmptyValue(v.Field(i)) {
+			if tagDefault := field.Tag.Get("default"); tagDefault != "" {
+				params[key] = tagDefault
+			}
+			continue
+		}
+
+		err := encodeValue(params, key, v.Field(i), field.Tag.Get("format"), !top)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeValue(params map[string]string, key string, v reflect.Value, format string, nested bool) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return encodeValue(params, key, v.Elem(), format, nested)
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Elem().Kind() == reflect.String {
+			params[key] = v.Elem().String()
+			return nil
+		}
+		return encodeJSON(params, key, v.Elem())
+	case reflect.Map:
+		if v.Len() == 0 {
+			return nil
+		}
+		return encodeJSON(params, key, v)
+	case reflect.Struct:
+		if v.Type() == timeType {
+			params[key] = utils.TimeToString(v.Interface().(time.Time), timeFormat(format))
+			return nil
+		}
+		return encodeStruct(params, key+".", v, false)
+	case reflect.Slice, reflect.Array:
+		if nested && isScalarType(v.Type().Elem()) {
+			values := []string{}
+			for i := 0; i < v.Len(); i++ {
+				if value, ok := encodeScalar(indirect(v.Index(i)), format); ok {
+					values = append(values, value)
+				}
+			}
+			if len(values) != 0 {
+				params[key] = strings.Join(values, ",")
+			}
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			err := encodeValue(params, key+"."+strconv.Itoa(i+1), v.Index(i), format, nested)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	value, ok := encodeScalar(v, format)
+	if !ok {
+		return errors.ParameterTypeNotSupportedError{
+			ParameterName: key,
+			ParameterType: v.Type().String(),
+		}
+	}
+	params[key] = value
+	return nil
+}
+
+func encodeScalar(v reflect.Value, format string) (string, bool) {
+	if !v.IsValid() {
+		return "", false
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		if v.Bool() {
+			return "1", true
+		}
+		return "0", true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), true
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return utils.TimeToString(v.Interface().(time.Time), timeFormat(format)), true
+		}
+	}
+	return "", false
+}
+
+func encodeJSON(params map[string]string, key string, v reflect.Value) error {
+	content, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	params[key] = string(content)
+	return nil
+}
+
+// DecodeParams decodes QingCloud request params into an input struct, it's
+// the reverse of EncodeParams and follows the same rules.
+func DecodeParams(params map[string]string, input interface{}) error {
+	v := reflect.ValueOf(input)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("input must be a non-nil struct pointer")
+	}
+
+	return decodeStruct(params, "", v.Elem(), true)
+}
+
+func decodeStruct(params map[string]string, prefix string, v reflect.Value, top bool) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := field.Tag.Get("name")
+		if name == "" || field.PkgPath != "" {
+			continue
+		}
+		if top && field.Tag.Get("location") == "" {
+			continue
+		}
+
+		key := prefix + name
+		if !hasParam(params, key) {
+			continue
+		}
+
+		err := decodeValue(params, key, v.Field(i), field.Tag.Get("format"), !top)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeValue(params map[string]string, key string, v reflect.Value, format string, nested bool) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeValue(params, key, v.Elem(), format, nested)
+	case reflect.Interface:
+		value := params[key]
+		var decoded interface{}
+		if json.Unmarshal([]byte(value), &decoded) != nil {
+			decoded = value
+		}
+		if decoded != nil {
+			v.Set(reflect.ValueOf(decoded))
+		}
+		return nil
+	case reflect.Map:
+		target := reflect.New(v.Type())
+		err := json.Unmarshal([]byte(params[key]), target.Interface())
+		if err != nil {
+			return fmt.Errorf(`decode "%s": %s`, key, err.Error())
+		}
+		v.Set(target.Elem())
+		return nil
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return decodeScalar(key, params[key], v, format)
+		}
+		return decodeStruct(params, key+".", v, false)
+	case reflect.Slice:
+		if nested && isScalarType(v.Type().Elem()) {
+			value, ok := params[key]
+			if !ok {
+				return nil
+			}
+			items := strings.Split(value, ",")
+			slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+			for i, item := range items {
+				err := decodeValue(map[string]string{key: item}, key, slice.Index(i), format, nested)
+				if err != nil {
+					return err
+				}
+			}
+			v.Set(slice)
+			return nil
+		}
+
+		count := 0
+		for index := range params {
+			n := sliceIndex(key, index)
+			if n > count {
+				count = n
+			}
+		}
+		slice := reflect.MakeSlice(v.Type(), count, count)
+		for i := 0; i < count; i++ {
+			itemKey := key + "." + strconv.Itoa(i+1)
+			if !hasParam(params, itemKey) {
+				continue
+			}
+			err := decodeValue(params, itemKey, slice.Index(i), format, nested)
+			if err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
+
+	value, ok := params[key]
+	if !ok {
+		return nil
+	}
+	return decodeScalar(key, value, v, format)
+}
+
+func decodeScalar(key string, value string, v reflect.Value, format string) error {
+	var err error
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+		return nil
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(value)
+		if err == nil {
+			v.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		i, err = strconv.ParseInt(value, 10, v.Type().Bits())
+		if err == nil {
+			v.SetInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		u, err = strconv.ParseUint(value, 10, v.Type().Bits())
+		if err == nil {
+			v.SetUint(u)
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(value, v.Type().Bits())
+		if err == nil {
+			v.SetFloat(f)
+		}
+	case reflect.Struct:
+		if v.Type() != timeType {
+			return errors.ParameterTypeNotSupportedError{ParameterName: key, ParameterType: v.Type().String()}
+		}
+		var t time.Time
+		t, err = utils.StringToTime(value, timeFormat(format))
+		if err == nil {
+			v.Set(reflect.ValueOf(t))
+		}
+	default:
+		return errors.ParameterTypeNotSupportedError{ParameterName: key, ParameterType: v.Type().String()}
+	}
+
+	if err != nil {
+		return fmt.Errorf(`decode "%s": %s`, key, err.Error())
+	}
+	return nil
+}
+
+// hasParam reports whether params contains key or any key nested under it.
+func hasParam(params map[string]string, key string) bool {
+	if _, ok := params[key]; ok {
+		return true
+	}
+	for k := range params {
+		if strings.HasPrefix(k, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// sliceIndex returns n if param is "key.n" or nested under it, or 0.
+func sliceIndex(key string, param string) int {
+	if !strings.HasPrefix(param, key+".") {
+		return 0
+	}
+	rest := param[len(key)+1:]
+	if i := strings.Index(rest, "."); i >= 0 {
+		rest = rest[:i]
+	}
+	n, err := strconv.Atoi(rest)
+	if err != nil || n < 1 {
+		return 0
+	}
+	return n
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return false
+}
+
+func isScalarType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Struct:
+		return t == timeType
+	}
+	return false
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func timeFormat(format string) string {
+	if format == "" {
+		return "ISO 8601"
+	}
+	return format
+}
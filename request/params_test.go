@@ -0,0 +1,139 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+type ParamsBackend struct {
+	BackendName *string    `json:"backend_name" name:"backend_name"`
+	Port        *int       `json:"port" name:"port"`
+	Weight      *int       `json:"weight" name:"weight" default:"5"`
+	CreateTime  *time.Time `json:"create_time" name:"create_time" format:"ISO 8601"`
+}
+
+type ParamsListener struct {
+	ListenerPort *int             `json:"listener_port" name:"listener_port"`
+	Certificates []*string        `json:"certificates" name:"certificates"`
+	Backends     []*ParamsBackend `json:"backends" name:"backends"`
+}
+
+type ParamsInput struct {
+	Name      *string            `json:"name" name:"name" location:"params"`
+	Count     *int               `json:"count" name:"count" default:"1" location:"params"`
+	Enabled   *bool              `json:"enabled" name:"enabled" location:"params"`
+	Ratio     *float64           `json:"ratio" name:"ratio" location:"params"`
+	StartTime *time.Time         `json:"start_time" name:"start_time" format:"ISO 8601" location:"params"`
+	Tags      []*string          `json:"tags" name:"tags" location:"params"`
+	Ports     []*int             `json:"ports" name:"ports" location:"params"`
+	Backend   *ParamsBackend     `json:"backend" name:"backend" location:"params"`
+	Listeners []*ParamsListener  `json:"listeners" name:"listeners" location:"params"`
+	Labels    map[string]*string `json:"labels" name:"labels" location:"params"`
+	Env       interface{}        `json:"env" name:"env" location:"params"`
+	Ignored   *string            `json:"ignored" name:"ignored"`
+}
+
+func TestEncodeParams(t *testing.T) {
+	startTime := time.Date(2018, 3, 26, 8, 0, 0, 0, time.UTC)
+	params, err := EncodeParams(&ParamsInput{
+		Name:      String("name"),
+		Enabled:   Bool(true),
+		Ratio:     Float64(0.5),
+		StartTime: &startTime,
+		Tags:      []*string{String("tag-1"), nil, String("tag-3")},
+		Ports:     []*int{Int(80)},
+		Backend:   &ParamsBackend{BackendName: String("backend"), Port: Int(8080)},
+		Listeners: []*ParamsListener{{
+			ListenerPort: Int(443),
+			Certificates: StringSlice([]string{"sc-1", "sc-2"}),
+			Backends:     []*ParamsBackend{{Port: Int(8443)}},
+		}},
+		Labels:  map[string]*string{"app": String("web")},
+		Env:     map[string]interface{}{"debug": true},
+		Ignored: String("ignored"),
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]string{
+		"name":                        "name",
+		"count":                       "1",
+		"enabled":                     "1",
+		"ratio":                       "0.5",
+		"start_time":                  "2018-03-26T08:00:00Z",
+		"tags.1":                      "tag-1",
+		"tags.3":                      "tag-3",
+		"ports.1":                     "80",
+		"backend.backend_name":        "backend",
+		"backend.port":                "8080",
+		"listeners.1.listener_port":   "443",
+		"listeners.1.certificates":    "sc-1,sc-2",
+		"listeners.1.backends.1.port": "8443",
+		"labels":                      `{"app":"web"}`,
+		"env":                         `{"debug":true}`,
+	}, params)
+}
+
+func TestEncodeParamsWithUnsupportedType(t *testing.T) {
+	type UnsupportedInput struct {
+		Callback func() `name:"callback" location:"params"`
+	}
+
+	_, err := EncodeParams(&UnsupportedInput{Callback: func() {}})
+	assert.Equal(t, errors.ParameterTypeNotSupportedError{
+		ParameterName: "callback",
+		ParameterType: "func()",
+	}, err)
+}
+
+func TestDecodeParams(t *testing.T) {
+	startTime := time.Date(2018, 3, 26, 8, 0, 0, 0, time.UTC)
+	input := &ParamsInput{
+		Name:      String("name"),
+		Count:     Int(3),
+		Enabled:   Bool(false),
+		Ratio:     Float64(1.25),
+		StartTime: &startTime,
+		Tags:      []*string{String("tag-1"), nil, String("tag-3")},
+		Ports:     []*int{Int(80), Int(443)},
+		Backend:   &ParamsBackend{BackendName: String("backend"), CreateTime: &startTime},
+		Listeners: []*ParamsListener{{
+			ListenerPort: Int(443),
+			Certificates: StringSlice([]string{"sc-1", "sc-2"}),
+			Backends:     []*ParamsBackend{{Port: Int(8443), Weight: Int(10)}},
+		}, {
+			ListenerPort: Int(80),
+		}},
+		Labels: map[string]*string{"app": String("web")},
+		Env:    map[string]interface{}{"debug": true},
+	}
+
+	params, err := EncodeParams(input)
+	assert.Nil(t, err)
+
+	decoded := &ParamsInput{}
+	err = DecodeParams(params, decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, input, decoded)
+
+	err = DecodeParams(map[string]string{"count": "many"}, decoded)
+	assert.NotNil(t, err)
+}
@@ -17,15 +17,19 @@
 package request
 
 import (
-	"errors"
+	"context"
+	goerrors "errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"reflect"
 	"time"
 
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/credentials"
 	"github.com/yunify/qingcloud-sdk-go/logger"
 	"github.com/yunify/qingcloud-sdk-go/request/data"
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
 	"github.com/yunify/qingcloud-sdk-go/utils"
 )
 
@@ -37,19 +41,26 @@ type Request struct {
 
 	HTTPRequest  *http.Request
 	HTTPResponse *http.Response
+
+	Handlers    *Handlers
+	Credentials credentials.Value
+	Error       error
+	RetryCount  int
+
+	ctx context.Context
 }
 
 // DefaultCredentialProxyHost is default credential proxy host
-const DefaultCredentialProxyHost = "169.254.169.254"
+const DefaultCredentialProxyHost = config.DefaultCredentialProxyHost
 
 // DefaultCredentialProxyPort is default credential proxy port
-const DefaultCredentialProxyPort = 80
+const DefaultCredentialProxyPort = config.DefaultCredentialProxyPort
 
 // DefaultCredentialProxyProtocol is default credential proxy protocol
-const DefaultCredentialProxyProtocol = "http"
+const DefaultCredentialProxyProtocol = config.DefaultCredentialProxyProtocol
 
 // DefaultCredentialProxyURI is default credential proxy URI
-const DefaultCredentialProxyURI = "/latest/meta-data/security-credentials"
+const DefaultCredentialProxyURI = config.DefaultCredentialProxyURI
 
 // TokenOutput is the structure of token when retrieving it
 type TokenOutput struct {
@@ -78,81 +89,131 @@ func New(o *data.Operation, i data.Input, x interface{}) (*Request, error) {
 		Operation: o,
 		Input:     &input,
 		Output:    &output,
+		Handlers:  DefaultHandlers(),
 	}, nil
 }
 
+// Context returns the context of the request, it's never nil.
+func (r *Request) Context() context.Context {
+	if r.ctx != nil {
+		return r.ctx
+	}
+	return context.Background()
+}
+
 // Send sends API request.
 // It returns error if error occurred.
 func (r *Request) Send() error {
-	err := r.check()
-	if err != nil {
-		return err
+	return r.SendWithContext(context.Background())
+}
+
+// SendWithContext sends API request with the given context. Cancelling the
+// context aborts the credential fetch, the HTTP round trip and any retries.
+// Each attempt waits for the rate limiter of the Config first, if any.
+// It returns error if error occurred.
+func (r *Request) SendWithContext(ctx context.Context) error {
+	if ctx == nil {
+		return goerrors.New("context must be non-nil")
 	}
 
-	err = r.build()
-	if err != nil {
-		return err
+	r.ctx = ctx
+	if r.Handlers == nil {
+		r.Handlers = DefaultHandlers()
 	}
 
-	err = r.sign()
-	if err != nil {
-		return err
+	r.Error = r.run(ctx)
+	if goerrors.Is(r.Error, errors.ErrAuthFailure) && r.Credentials.Token != "" {
+		// The token may be revoked before its expiration, get a new one.
+		r.Operation.Config.GetCredentials().Expire()
 	}
 
-	err = r.send()
-	if err != nil {
-		return err
+	err := r.Handlers.Complete.Run(r)
+	if r.Error == nil {
+		r.Error = err
 	}
 
-	err = r.unpack()
+	return r.Error
+}
+
+func (r *Request) run(ctx context.Context) error {
+	retryer := r.Operation.Config.GetRetryer()
+	limiter, err := r.Operation.Config.GetRateLimiterWithContext(ctx)
 	if err != nil {
 		return err
 	}
+	for r.RetryCount = 0; ; r.RetryCount++ {
+		// Wait before building, so that the signed timestamp is fresh.
+		if limiter != nil {
+			if err := limiter.Wait(ctx, r.Operation.APIName); err != nil {
+				return err
+			}
+		}
 
-	return nil
-}
-
-func (r *Request) check() error {
-	if r.Operation.Config.AccessKeyID == "" && r.Operation.Config.SecretAccessKey == "" || r.Operation.Config.URI == "/iam" && r.isTokenExpired() {
-		t := TokenOutput{}
-		err := t.GetToken(r.getCredentialProxyURL())
+		err = r.Handlers.Build.Run(r)
+		if err != nil {
+			return err
+		}
 
+		err = r.Handlers.Sign.Run(r)
 		if err != nil {
 			return err
 		}
-		r.Operation.Config.AccessKeyID = t.AccessKey
-		r.Operation.Config.SecretAccessKey = t.SecretAccess
-		r.Operation.Config.URI = "/iam"
-		r.Operation.Config.Token = t.Token
-		r.Operation.Config.Expiration = t.Expiration
-	}
 
-	if r.Operation.Config.AccessKeyID == "" {
-		return errors.New("access key not provided")
+		err = r.Handlers.Send.Run(r)
+		if err == nil {
+			err = r.Handlers.Unpack.Run(r)
+		}
+		if limiter != nil {
+			limiter.Observe(r.Operation.APIName, err)
+		}
+		if err == nil {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if r.RetryCount >= retryer.MaxRetries() || !retryer.ShouldRetry(err) {
+			return err
+		}
+
+		delay := retryer.RetryDelay(r.RetryCount + 1)
+		logger.Warn(fmt.Sprintf(
+			"Retrying request %s in %s: %s", r.Operation.APIName, delay, err.Error()))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
 	}
+}
 
-	if r.Operation.Config.SecretAccessKey == "" {
-		return errors.New("secret access key not provided")
+func (r *Request) check(ctx context.Context) error {
+	value, err := r.Operation.Config.RetrieveCredentials(ctx)
+	if err != nil {
+		return err
 	}
 
+	r.Credentials = value
 	return nil
 }
 
-func (r *Request) build() error {
-	b := &Builder{}
+func (r *Request) build(ctx context.Context) error {
+	b := &Builder{Token: r.Credentials.Token}
 	httpRequest, err := b.BuildHTTPRequest(r.Operation, r.Input)
 	if err != nil {
 		return err
 	}
 
-	r.HTTPRequest = httpRequest
+	r.HTTPRequest = httpRequest.WithContext(ctx)
 	return nil
 }
 
 func (r *Request) sign() error {
 	s := &Signer{
-		AccessKeyID:     r.Operation.Config.AccessKeyID,
-		SecretAccessKey: r.Operation.Config.SecretAccessKey,
+		AccessKeyID:     r.Credentials.AccessKeyID,
+		SecretAccessKey: r.Credentials.SecretAccessKey,
 	}
 	err := s.WriteSignature(r.HTTPRequest)
 	if err != nil {
@@ -162,33 +223,17 @@ func (r *Request) sign() error {
 	return nil
 }
 
-func (r *Request) send() error {
-	var response *http.Response
-	var err error
-
+func (r *Request) send(ctx context.Context) error {
 	if r.Operation.Config.Connection == nil {
-		return errors.New("connection not initialized")
+		return goerrors.New("connection not initialized")
 	}
 
-	retries := r.Operation.Config.ConnectionRetries + 1
-	for {
-		if retries > 0 {
-			logger.Info(fmt.Sprintf(
-				"Sending request: [%d] %s",
-				utils.StringToUnixInt(r.HTTPRequest.Header.Get("Date"), "RFC 822"),
-				r.HTTPRequest.Host))
-
-			response, err = r.Operation.Config.Connection.Do(r.HTTPRequest)
-			if err == nil {
-				retries = 0
-			} else {
-				retries--
-				time.Sleep(time.Second)
-			}
-		} else {
-			break
-		}
-	}
+	logger.Info(fmt.Sprintf(
+		"Sending request: [%d] %s",
+		utils.StringToUnixInt(r.HTTPRequest.Header.Get("Date"), "RFC 822"),
+		r.HTTPRequest.Host))
+
+	response, err := r.Operation.Config.Connection.Do(r.HTTPRequest)
 	if err != nil {
 		return err
 	}
@@ -210,10 +255,22 @@ func (r *Request) unpack() error {
 
 // GetToken is used to get token from credential proxy server
 func (t *TokenOutput) GetToken(credentialProxyURL string) error {
-	response, err := http.Get(credentialProxyURL)
+	return t.GetTokenWithContext(context.Background(), credentialProxyURL)
+}
+
+// GetTokenWithContext is the same as GetToken with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (t *TokenOutput) GetTokenWithContext(ctx context.Context, credentialProxyURL string) error {
+	httpRequest, err := http.NewRequest("GET", credentialProxyURL, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := http.DefaultClient.Do(httpRequest.WithContext(ctx))
 	if err != nil {
 		return err
 	}
+	defer response.Body.Close()
 
 	content, err := ioutil.ReadAll(response.Body)
 
@@ -225,48 +282,3 @@ func (t *TokenOutput) GetToken(credentialProxyURL string) error {
 
 	return err
 }
-
-func (r *Request) isTokenExpired() bool {
-	if r.Operation.Config.Token == "" {
-		return true
-	}
-
-	now := time.Now().UTC().Unix()
-
-	return now >= r.Operation.Config.Expiration
-}
-
-func (r *Request) getCredentialProxyURL() string {
-	var credentialProxyProtocol string
-	var credentialProxyHost string
-	var credentialProxyPort int
-	var credentialProxyURI string
-
-	if r.Operation.Config.CredentialProxyProtocol != "" {
-		credentialProxyProtocol = r.Operation.Config.CredentialProxyProtocol
-	} else {
-		credentialProxyProtocol = DefaultCredentialProxyProtocol
-	}
-
-	if r.Operation.Config.CredentialProxyHost != "" {
-		credentialProxyHost = r.Operation.Config.CredentialProxyHost
-	} else {
-		credentialProxyHost = DefaultCredentialProxyHost
-	}
-
-	if r.Operation.Config.CredentialProxyPort != 0 {
-		credentialProxyPort = r.Operation.Config.CredentialProxyPort
-	} else {
-		credentialProxyPort = DefaultCredentialProxyPort
-	}
-
-	if r.Operation.Config.CredentialProxyURI != "" {
-		credentialProxyURI = r.Operation.Config.CredentialProxyURI
-	} else {
-		credentialProxyURI = DefaultCredentialProxyURI
-	}
-
-	credentialProxyURL := fmt.Sprintf("%s://%s:%d%s", credentialProxyProtocol, credentialProxyHost, credentialProxyPort, credentialProxyURI)
-
-	return credentialProxyURL
-}
@@ -0,0 +1,268 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/credentials"
+	"github.com/yunify/qingcloud-sdk-go/request/data"
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+func newTestRequest(t *testing.T, serverURL string) *Request {
+	conf, err := config.New("AccessKeyID", "SecretAccessKey")
+	assert.Nil(t, err)
+
+	u, err := url.Parse(serverURL)
+	assert.Nil(t, err)
+	conf.Protocol = u.Scheme
+	conf.Host = u.Hostname()
+	conf.Port, _ = strconv.Atoi(u.Port())
+
+	operation := &data.Operation{
+		Config: conf,
+		Properties: &InstanceServiceProperties{
+			Zone: String("beta"),
+		},
+		APIName:       "DescribeInstances",
+		RequestMethod: "GET",
+	}
+	r, err := New(operation, &DescribeInstancesInput{}, &DescribeInstancesOutput{})
+	assert.Nil(t, err)
+	return r
+}
+
+type DescribeInstancesOutput struct {
+	Message *string `json:"message" name:"message"`
+	RetCode *int    `json:"ret_code" name:"ret_code" location:"elements"`
+}
+
+func TestRequest_SendWithContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"action":"DescribeInstancesResponse","ret_code":0}`))
+	}))
+	defer server.Close()
+
+	r := newTestRequest(t, server.URL)
+	err := r.SendWithContext(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, IntValue(r.Output.Interface().(*DescribeInstancesOutput).RetCode))
+}
+
+func TestRequest_SendWithCanceledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	r := newTestRequest(t, server.URL)
+	r.Operation.Config.ConnectionRetries = 10
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := r.SendWithContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestRequest_SendWithRetryableRetCode(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts < 3 {
+			w.Write([]byte(`{"message":"server busy","ret_code":5100}`))
+			return
+		}
+		w.Write([]byte(`{"action":"DescribeInstancesResponse","ret_code":0}`))
+	}))
+	defer server.Close()
+
+	r := newTestRequest(t, server.URL)
+	r.Operation.Config.Retryer = &config.DefaultRetryer{
+		NumMaxRetries:     3,
+		MinRetryDelay:     time.Millisecond,
+		MaxRetryDelay:     time.Millisecond,
+		RetryableRetCodes: config.DefaultRetryableRetCodes,
+	}
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	r = newTestRequest(t, server.URL)
+	r.Operation.Config.Retryer = &config.DefaultRetryer{
+		NumMaxRetries:     1,
+		MinRetryDelay:     time.Millisecond,
+		RetryableRetCodes: config.DefaultRetryableRetCodes,
+	}
+	err = r.Send()
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+type testRateLimiter struct {
+	waits    []string
+	observed []error
+}
+
+func (l *testRateLimiter) Wait(ctx context.Context, apiName string) error {
+	l.waits = append(l.waits, apiName)
+	return ctx.Err()
+}
+
+func (l *testRateLimiter) Observe(apiName string, err error) {
+	l.observed = append(l.observed, err)
+}
+
+func TestRequest_SendWithRateLimiter(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		if attempts < 2 {
+			w.Write([]byte(`{"message":"too many requests","ret_code":1500}`))
+			return
+		}
+		w.Write([]byte(`{"action":"DescribeInstancesResponse","ret_code":0}`))
+	}))
+	defer server.Close()
+
+	limiter := &testRateLimiter{}
+	r := newTestRequest(t, server.URL)
+	r.Operation.Config.RateLimiter = limiter
+	r.Operation.Config.Retryer = &config.DefaultRetryer{
+		NumMaxRetries:     3,
+		MinRetryDelay:     time.Millisecond,
+		MaxRetryDelay:     time.Millisecond,
+		RetryableRetCodes: config.DefaultRetryableRetCodes,
+	}
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"DescribeInstances", "DescribeInstances"}, limiter.waits)
+	if assert.Len(t, limiter.observed, 2) {
+		assert.True(t, goerrors.Is(limiter.observed[0], errors.ErrThrottled))
+		assert.Nil(t, limiter.observed[1])
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = newTestRequest(t, server.URL)
+	r.Operation.Config.RateLimiter = limiter
+	err = r.SendWithContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRequest_SendWithRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"action":"DescribeInstancesResponse","ret_code":0}`))
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		r := newTestRequest(t, server.URL)
+		r.Operation.Config.AccessKeyID = "RateLimitedAccessKeyID"
+		r.Operation.Config.RateLimit = 20
+		r.Operation.Config.RateBurst = 1
+		assert.Nil(t, r.Send())
+	}
+	assert.True(t, time.Since(start) >= 90*time.Millisecond)
+}
+
+func TestRequest_SendWithTokenCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/iam", r.URL.Path)
+		assert.Equal(t, "Token", r.URL.Query().Get("token"))
+		assert.Equal(t, "TokenAccessKeyID", r.URL.Query().Get("access_key_id"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"action":"DescribeInstancesResponse","ret_code":0}`))
+	}))
+	defer server.Close()
+
+	r := newTestRequest(t, server.URL)
+	r.Operation.Config.AccessKeyID = ""
+	r.Operation.Config.SecretAccessKey = ""
+	r.Operation.Config.Credentials = credentials.NewCredentials(&credentials.StaticProvider{
+		Value: credentials.Value{
+			AccessKeyID:     "TokenAccessKeyID",
+			SecretAccessKey: "TokenSecretAccessKey",
+			Token:           "Token",
+			Expiration:      time.Now().Add(time.Hour),
+		},
+	})
+
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, "Token", r.Credentials.Token)
+}
+
+func TestRequest_SendConcurrentlyWithSharedCredentials(t *testing.T) {
+	tokenRequests := int32(0)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokenRequests, 1)
+		time.Sleep(50 * time.Millisecond)
+		fmt.Fprintf(w, `{"id_token":"Token","access_key":"TokenAccessKeyID","secret_key":"TokenSecretAccessKey","expiration":%d}`,
+			time.Now().Add(time.Hour).Unix())
+	}))
+	defer proxy.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Token", r.URL.Query().Get("token"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"action":"DescribeInstancesResponse","ret_code":0}`))
+	}))
+	defer server.Close()
+
+	conf := newTestRequest(t, server.URL).Operation.Config
+	conf.AccessKeyID = ""
+	conf.SecretAccessKey = ""
+	conf.Credentials = credentials.NewCredentials(&credentials.MetadataProvider{URL: proxy.URL})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		r := newTestRequest(t, server.URL)
+		r.Operation.Config = conf
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, r.Send())
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tokenRequests))
+}
@@ -35,6 +35,8 @@ type Unpacker struct {
 
 	httpResponse *http.Response
 	output       *reflect.Value
+
+	body []byte
 }
 
 // UnpackHTTPRequest unpack the http response with an operation, http response and an output.
@@ -57,25 +59,25 @@ func (u *Unpacker) UnpackHTTPRequest(o *data.Operation, r *http.Response, x *ref
 }
 
 func (u *Unpacker) parseResponse() error {
-	if u.httpResponse.StatusCode == 200 {
-		var resp = u.httpResponse
-		if resp == nil {
-			return fmt.Errorf("http response is nil point")
-		}
+	if u.httpResponse == nil {
+		return fmt.Errorf("http response is nil point")
+	}
 
-		var contentType = resp.Header.Get("Content-Type")
+	if u.httpResponse.StatusCode == 200 {
+		var contentType = u.httpResponse.Header.Get("Content-Type")
 
 		if strings.HasPrefix(contentType, "application/json") {
 			buffer := &bytes.Buffer{}
 			buffer.ReadFrom(u.httpResponse.Body)
 			u.httpResponse.Body.Close()
+			u.body = buffer.Bytes()
 
 			logger.Info(fmt.Sprintf(
 				"Response json string: [%d] %s",
 				utils.StringToUnixInt(u.httpResponse.Header.Get("Date"), "RFC 822"),
-				string(buffer.Bytes())))
+				string(u.body)))
 
-			_, err := utils.JSONDecode(buffer.Bytes(), u.output.Interface())
+			_, err := utils.JSONDecode(u.body, u.output.Interface())
 			if err != nil {
 				return err
 			}
@@ -85,8 +87,22 @@ func (u *Unpacker) parseResponse() error {
 			}
 		}
 	} else {
+		buffer := &bytes.Buffer{}
+		buffer.ReadFrom(u.httpResponse.Body)
 		u.httpResponse.Body.Close()
-		err := fmt.Errorf("Response StatusCode: %d", u.httpResponse.StatusCode)
+		u.body = buffer.Bytes()
+
+		err := &errors.QingCloudError{
+			StatusCode: u.httpResponse.StatusCode,
+			Action:     u.operation.APIName,
+			Body:       u.body,
+		}
+		// Gateways may still respond with a QingCloud error body.
+		utils.JSONDecode(u.body, err)
+		if err.Message == "" {
+			err.Message = http.StatusText(u.httpResponse.StatusCode)
+		}
+
 		logger.Error(err.Error())
 		return err
 	}
@@ -107,7 +123,10 @@ func (u *Unpacker) parseError() error {
 			return nil
 		}
 		err := &errors.QingCloudError{
-			RetCode: int(retCodeValue.Elem().Int()),
+			RetCode:    int(retCodeValue.Elem().Int()),
+			Action:     u.operation.APIName,
+			StatusCode: u.httpResponse.StatusCode,
+			Body:       u.body,
 		}
 		if messageValue.IsValid() && messageValue.Type().String() == "*string" {
 			if messageValue.Elem().IsValid() {
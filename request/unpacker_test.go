@@ -18,6 +18,7 @@ package request
 
 import (
 	"bytes"
+	goerrors "errors"
 	"io/ioutil"
 	"net/http"
 	"reflect"
@@ -196,3 +197,40 @@ func TestUnpacker_UnpackHTTPRequestWithWrongHTTPStatus(t *testing.T) {
 	}
 	println("err", err.Error())
 }
+
+func TestUnpacker_UnpackHTTPRequestWithErrorClass(t *testing.T) {
+	type StopInstancesOutput struct {
+		RetCode *int    `json:"ret_code" name:"ret_code"`
+		Message *string `json:"message" name:"message"`
+	}
+
+	httpResponse := &http.Response{Header: http.Header{}}
+	httpResponse.StatusCode = 200
+	httpResponse.Header.Set("Content-Type", "application/json")
+	responseString := `{"message":"PermissionDenied, instance [i-xxxxxxxx] is not running","ret_code":1400}`
+	httpResponse.Body = ioutil.NopCloser(bytes.NewReader([]byte(responseString)))
+
+	output := &StopInstancesOutput{}
+	outputValue := reflect.ValueOf(output)
+	unpacker := Unpacker{}
+	err := unpacker.UnpackHTTPRequest(&data.Operation{APIName: "StopInstances"}, httpResponse, &outputValue)
+	assert.True(t, goerrors.Is(err, errors.ErrPermissionDenied))
+	assert.False(t, goerrors.Is(err, errors.ErrResourceNotFound))
+
+	qcErr := &errors.QingCloudError{}
+	assert.True(t, goerrors.As(err, &qcErr))
+	assert.Equal(t, "StopInstances", qcErr.Action)
+	assert.Equal(t, 200, qcErr.StatusCode)
+	assert.Equal(t, responseString, string(qcErr.Body))
+
+	httpResponse = &http.Response{Header: http.Header{}}
+	httpResponse.StatusCode = 503
+	httpResponse.Body = ioutil.NopCloser(bytes.NewReader([]byte("<html>busy</html>")))
+
+	err = unpacker.UnpackHTTPRequest(&data.Operation{APIName: "StopInstances"}, httpResponse, &outputValue)
+	assert.True(t, goerrors.Is(err, errors.ErrResourceBusy))
+	assert.True(t, goerrors.As(err, &qcErr))
+	assert.Equal(t, 503, qcErr.StatusCode)
+	assert.Equal(t, "<html>busy</html>", string(qcErr.Body))
+	assert.Equal(t, "QingCloud Error: StatusCode (503), Message (Service Unavailable)", err.Error())
+}
@@ -0,0 +1,300 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/yunify/qingcloud-sdk-go/logger"
+)
+
+// Default values of Waiter.
+const (
+	DefaultWaiterMaxAttempts = 40
+	DefaultWaiterMinDelay    = time.Second
+	DefaultWaiterMaxDelay    = 20 * time.Second
+)
+
+// ErrWaiterFailure is returned by waiters reaching a failure acceptor.
+var ErrWaiterFailure = errors.New("waiter reached failure state")
+
+// ErrWaiterMaxAttempts is returned by waiters exceeding the max attempts.
+var ErrWaiterMaxAttempts = errors.New("waiter exceeded max attempts")
+
+// WaiterState is the state a waiter moves to when an acceptor matches.
+type WaiterState int
+
+// Waiter states.
+const (
+	RetryWaiterState WaiterState = iota
+	SuccessWaiterState
+	FailureWaiterState
+)
+
+// WaiterMatcher is how an acceptor matches the result of an attempt.
+type WaiterMatcher int
+
+// Waiter matchers.
+const (
+	// StatusAllWaiterMatch matches when all the values in StatusPath equal
+	// Expected, and there are Count values if Count is set.
+	StatusAllWaiterMatch WaiterMatcher = iota
+	// StatusAnyWaiterMatch matches when any value in StatusPath equals
+	// Expected.
+	StatusAnyWaiterMatch
+	// MissingWaiterMatch matches when there are less values in StatusPath
+	// than Count, or no value if Count is not set.
+	MissingWaiterMatch
+	// ErrorWaiterMatch matches when the error of Describe is Expected,
+	// checked by errors.Is.
+	ErrorWaiterMatch
+)
+
+// A WaiterAcceptor moves a waiter to State when it matches.
+type WaiterAcceptor struct {
+	State    WaiterState
+	Matcher  WaiterMatcher
+	Expected interface{}
+}
+
+// WaiterOption changes the settings of a Waiter.
+type WaiterOption func(w *Waiter)
+
+// WithWaiterMaxAttempts sets the max attempts of a Waiter.
+func WithWaiterMaxAttempts(maxAttempts int) WaiterOption {
+	return func(w *Waiter) {
+		w.MaxAttempts = maxAttempts
+	}
+}
+
+// WithWaiterDelay sets the min and max delay between attempts of a Waiter.
+func WithWaiterDelay(minDelay, maxDelay time.Duration) WaiterOption {
+	return func(w *Waiter) {
+		w.MinDelay = minDelay
+		w.MaxDelay = maxDelay
+	}
+}
+
+// A Waiter describes resources repeatedly until an acceptor reaches the
+// success or failure state.
+type Waiter struct {
+	Name string
+	// Describe returns the output containing the resources.
+	Describe func(ctx context.Context) (interface{}, error)
+	// StatusPath is the path of status values in the output of Describe,
+	// such as "InstanceSet[].Status".
+	StatusPath string
+	// TransitionStatusPath is the path of transition status values, all of
+	// them must be empty for the waiter to succeed.
+	TransitionStatusPath string
+	// Count is the number of values expected in StatusPath, 0 for any.
+	Count int
+
+	Acceptors   []WaiterAcceptor
+	MaxAttempts int
+	// The delay between attempts is doubled from MinDelay up to MaxDelay.
+	MinDelay time.Duration
+	MaxDelay time.Duration
+}
+
+// ApplyOptions applies the options to this Waiter.
+func (w *Waiter) ApplyOptions(options ...WaiterOption) {
+	for _, option := range options {
+		option(w)
+	}
+}
+
+// WaitWithContext runs Describe until an acceptor reaches the success state
+// and returns the output of the last attempt.
+// Errors of Describe not matched by any acceptor are retried.
+// It returns error if a failure state is reached, the max attempts is
+// exceeded or the context is done.
+func (w *Waiter) WaitWithContext(ctx context.Context) (interface{}, error) {
+	maxAttempts := w.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultWaiterMaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		output, err := w.Describe(ctx)
+		state, reason := w.match(output, err)
+		switch state {
+		case SuccessWaiterState:
+			logger.Debug("Waiter [%s] succeeded after %d attempts", w.Name, attempt)
+			return output, nil
+		case FailureWaiterState:
+			if reason == "" {
+				return nil, fmt.Errorf("%s: %w", w.Name, ErrWaiterFailure)
+			}
+			return nil, fmt.Errorf("%s: %w: %s", w.Name, ErrWaiterFailure, reason)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// Errors not matched by any acceptor may be transient, such as
+			// network errors, so they are retried like the retry state.
+			logger.Warn("Waiter [%s] attempt %d failed: %s", w.Name, attempt, err.Error())
+		}
+
+		if attempt >= maxAttempts {
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w: %d: %v", w.Name, ErrWaiterMaxAttempts, maxAttempts, err)
+			}
+			return nil, fmt.Errorf("%s: %w: %d", w.Name, ErrWaiterMaxAttempts, maxAttempts)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(w.delay(attempt)):
+		}
+	}
+}
+
+func (w *Waiter) delay(attempt int) time.Duration {
+	minDelay, maxDelay := w.MinDelay, w.MaxDelay
+	if minDelay <= 0 {
+		minDelay = DefaultWaiterMinDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultWaiterMaxDelay
+	}
+
+	delay := minDelay
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+func (w *Waiter) match(output interface{}, err error) (WaiterState, string) {
+	if err != nil {
+		for _, acceptor := range w.Acceptors {
+			if acceptor.Matcher != ErrorWaiterMatch {
+				continue
+			}
+			if expected, ok := acceptor.Expected.(error); ok && errors.Is(err, expected) {
+				return acceptor.State, err.Error()
+			}
+		}
+		return RetryWaiterState, ""
+	}
+
+	statuses := PathValues(output, w.StatusPath)
+	for _, acceptor := range w.Acceptors {
+		matched, reason := false, ""
+		switch acceptor.Matcher {
+		case StatusAllWaiterMatch:
+			matched = len(statuses) > 0 && (w.Count == 0 || len(statuses) == w.Count)
+			for _, status := range statuses {
+				if status != acceptor.Expected {
+					matched = false
+				}
+			}
+			reason = fmt.Sprintf("status is %v", acceptor.Expected)
+		case StatusAnyWaiterMatch:
+			for _, status := range statuses {
+				if status == acceptor.Expected {
+					matched = true
+				}
+			}
+			reason = fmt.Sprintf("status is %v", acceptor.Expected)
+		case MissingWaiterMatch:
+			matched = len(statuses) == 0 || len(statuses) < w.Count
+			reason = "resource not found"
+		}
+		if !matched {
+			continue
+		}
+
+		if acceptor.State == SuccessWaiterState && w.TransitionStatusPath != "" {
+			for _, transitionStatus := range PathValues(output, w.TransitionStatusPath) {
+				if transitionStatus != "" {
+					return RetryWaiterState, ""
+				}
+			}
+		}
+		return acceptor.State, reason
+	}
+
+	return RetryWaiterState, ""
+}
+
+// PathValues returns the values in the given path of v, such as
+// "InstanceSet[].VxNets[].PrivateIP". Pointers are dereferenced, nil
+// pointers become zero values, and "[]" flattens the values of slices.
+func PathValues(v interface{}, path string) []interface{} {
+	values := []reflect.Value{reflect.ValueOf(v)}
+	for _, field := range strings.Split(path, ".") {
+		flatten := strings.HasSuffix(field, "[]")
+		field = strings.TrimSuffix(field, "[]")
+
+		next := []reflect.Value{}
+		for _, value := range values {
+			value = indirectValue(value)
+			if value.Kind() != reflect.Struct {
+				continue
+			}
+			value = value.FieldByName(field)
+			if !value.IsValid() {
+				continue
+			}
+			if flatten {
+				if value.Kind() != reflect.Slice {
+					continue
+				}
+				for i := 0; i < value.Len(); i++ {
+					next = append(next, value.Index(i))
+				}
+			} else {
+				next = append(next, value)
+			}
+		}
+		values = next
+	}
+
+	result := []interface{}{}
+	for _, value := range values {
+		value = indirectValue(value)
+		if value.IsValid() {
+			result = append(result, value.Interface())
+		}
+	}
+	return result
+}
+
+func indirectValue(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			if value.Kind() == reflect.Ptr {
+				return reflect.Zero(value.Type().Elem())
+			}
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
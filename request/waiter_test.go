@@ -0,0 +1,176 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package request
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	qcerrors "github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+type waiterTestResource struct {
+	Status           *string
+	TransitionStatus *string
+}
+
+type waiterTestOutput struct {
+	ResourceSet []*waiterTestResource
+}
+
+func newWaiterTestOutput(statuses ...string) *waiterTestOutput {
+	output := &waiterTestOutput{}
+	for i := 0; i+1 < len(statuses); i += 2 {
+		output.ResourceSet = append(output.ResourceSet, &waiterTestResource{
+			Status:           String(statuses[i]),
+			TransitionStatus: String(statuses[i+1]),
+		})
+	}
+	return output
+}
+
+func newTestWaiter(outputs ...*waiterTestOutput) (*Waiter, *int) {
+	attempts := 0
+	return &Waiter{
+		Name: "WaitUntilResourcesStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			output := outputs[attempts]
+			attempts++
+			return output, nil
+		},
+		StatusPath:           "ResourceSet[].Status",
+		TransitionStatusPath: "ResourceSet[].TransitionStatus",
+		Count:                2,
+		Acceptors: []WaiterAcceptor{
+			{State: SuccessWaiterState, Matcher: StatusAllWaiterMatch, Expected: "running"},
+			{State: FailureWaiterState, Matcher: StatusAnyWaiterMatch, Expected: "ceased"},
+			{State: FailureWaiterState, Matcher: MissingWaiterMatch},
+		},
+		MinDelay: time.Millisecond,
+		MaxDelay: time.Millisecond,
+	}, &attempts
+}
+
+func TestWaiter_WaitWithContext(t *testing.T) {
+	w, attempts := newTestWaiter(
+		newWaiterTestOutput("pending", "creating", "pending", "creating"),
+		newWaiterTestOutput("running", "", "running", "starting"),
+		newWaiterTestOutput("running", "", "running", ""),
+	)
+	output, err := w.WaitWithContext(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, *attempts)
+	assert.Equal(t, 2, len(output.(*waiterTestOutput).ResourceSet))
+
+	w, _ = newTestWaiter(
+		newWaiterTestOutput("pending", "creating", "ceased", ""),
+	)
+	_, err = w.WaitWithContext(context.Background())
+	assert.True(t, errors.Is(err, ErrWaiterFailure))
+
+	w, _ = newTestWaiter(
+		newWaiterTestOutput("running", ""),
+	)
+	_, err = w.WaitWithContext(context.Background())
+	assert.True(t, errors.Is(err, ErrWaiterFailure))
+
+	w, _ = newTestWaiter(
+		newWaiterTestOutput("pending", "", "pending", ""),
+		newWaiterTestOutput("pending", "", "pending", ""),
+	)
+	w.ApplyOptions(WithWaiterMaxAttempts(2))
+	_, err = w.WaitWithContext(context.Background())
+	assert.True(t, errors.Is(err, ErrWaiterMaxAttempts))
+}
+
+func TestWaiter_WaitWithCanceledContext(t *testing.T) {
+	w, attempts := newTestWaiter(
+		newWaiterTestOutput("pending", "", "pending", ""),
+		newWaiterTestOutput("pending", "", "pending", ""),
+	)
+	w.ApplyOptions(WithWaiterDelay(time.Hour, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := w.WaitWithContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 1, *attempts)
+}
+
+func TestWaiter_ErrorAcceptor(t *testing.T) {
+	w := &Waiter{
+		Name: "WaitUntilResourcesDeleted",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			return nil, &qcerrors.QingCloudError{RetCode: qcerrors.RetCodeResourceNotFound}
+		},
+		Acceptors: []WaiterAcceptor{
+			{State: SuccessWaiterState, Matcher: ErrorWaiterMatch, Expected: qcerrors.ErrResourceNotFound},
+		},
+	}
+	_, err := w.WaitWithContext(context.Background())
+	assert.Nil(t, err)
+}
+
+func TestWaiter_RetryUnmatchedError(t *testing.T) {
+	w, attempts := newTestWaiter(
+		nil,
+		newWaiterTestOutput("running", "", "running", ""),
+	)
+	describe := w.Describe
+	w.Describe = func(ctx context.Context) (interface{}, error) {
+		if *attempts == 0 {
+			*attempts++
+			return nil, &qcerrors.QingCloudError{RetCode: qcerrors.RetCodeServerBusy, StatusCode: 503}
+		}
+		return describe(ctx)
+	}
+	output, err := w.WaitWithContext(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, *attempts)
+	assert.Equal(t, 2, len(output.(*waiterTestOutput).ResourceSet))
+
+	failure := errors.New("connection refused")
+	w.Describe = func(ctx context.Context) (interface{}, error) {
+		*attempts++
+		return nil, failure
+	}
+	w.ApplyOptions(WithWaiterMaxAttempts(3))
+	*attempts = 0
+	_, err = w.WaitWithContext(context.Background())
+	assert.True(t, errors.Is(err, ErrWaiterMaxAttempts))
+	assert.Contains(t, err.Error(), "connection refused")
+	assert.Equal(t, 3, *attempts)
+}
+
+func TestWaiter_Delay(t *testing.T) {
+	w := &Waiter{MinDelay: time.Second, MaxDelay: 5 * time.Second}
+	assert.Equal(t, time.Second, w.delay(1))
+	assert.Equal(t, 2*time.Second, w.delay(2))
+	assert.Equal(t, 4*time.Second, w.delay(3))
+	assert.Equal(t, 5*time.Second, w.delay(4))
+}
+
+func TestPathValues(t *testing.T) {
+	output := newWaiterTestOutput("running", "", "stopped", "starting")
+	output.ResourceSet = append(output.ResourceSet, &waiterTestResource{})
+	assert.Equal(t, []interface{}{"running", "stopped", ""}, PathValues(output, "ResourceSet[].Status"))
+	assert.Equal(t, []interface{}{}, PathValues(output, "ResourceSet[].Unknown"))
+	assert.Equal(t, []interface{}{}, PathValues(nil, "ResourceSet[].Status"))
+}
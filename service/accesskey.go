@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type AccesskeyService struct {
 	Config     *config.Config
 	Properties *AccesskeyServiceProperties
+	Handlers   *request.Handlers
 }
 
 type AccesskeyServiceProperties struct {
@@ -44,10 +46,16 @@ func (s *QingCloudService) Accesskey(zone string) (*AccesskeyService, error) {
 		Zone: &zone,
 	}
 
-	return &AccesskeyService{Config: s.Config, Properties: properties}, nil
+	return &AccesskeyService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 func (s *AccesskeyService) DeleteAccessKeys(i *DeleteAccessKeysInput) (*DeleteAccessKeysOutput, error) {
+	return s.DeleteAccessKeysWithContext(context.Background(), i)
+}
+
+// DeleteAccessKeysWithContext is the same as DeleteAccessKeys with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *AccesskeyService) DeleteAccessKeysWithContext(ctx context.Context, i *DeleteAccessKeysInput) (*DeleteAccessKeysOutput, error) {
 	if i == nil {
 		i = &DeleteAccessKeysInput{}
 	}
@@ -63,8 +71,12 @@ func (s *AccesskeyService) DeleteAccessKeys(i *DeleteAccessKeysInput) (*DeleteAc
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +109,12 @@ type DeleteAccessKeysOutput struct {
 }
 
 func (s *AccesskeyService) DescribeAccessKeys(i *DescribeAccessKeysInput) (*DescribeAccessKeysOutput, error) {
+	return s.DescribeAccessKeysWithContext(context.Background(), i)
+}
+
+// DescribeAccessKeysWithContext is the same as DescribeAccessKeys with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *AccesskeyService) DescribeAccessKeysWithContext(ctx context.Context, i *DescribeAccessKeysInput) (*DescribeAccessKeysOutput, error) {
 	if i == nil {
 		i = &DescribeAccessKeysInput{}
 	}
@@ -112,8 +130,12 @@ func (s *AccesskeyService) DescribeAccessKeys(i *DescribeAccessKeysInput) (*Desc
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -143,3 +165,56 @@ type DescribeAccessKeysOutput struct {
 	RetCode      *int         `json:"ret_code" name:"ret_code" location:"elements"`
 	TotalCount   *int         `json:"total_count" name:"total_count" location:"elements"`
 }
+
+// DescribeAccessKeysPaginator pages through the results of DescribeAccessKeys.
+type DescribeAccessKeysPaginator struct {
+	request.Pagination
+	service *AccesskeyService
+	input   DescribeAccessKeysInput
+}
+
+// NewDescribeAccessKeysPaginator create a paginator of DescribeAccessKeys starting at
+// the Offset of input.
+func NewDescribeAccessKeysPaginator(s *AccesskeyService, i *DescribeAccessKeysInput) *DescribeAccessKeysPaginator {
+	if i == nil {
+		i = &DescribeAccessKeysInput{}
+	}
+	return &DescribeAccessKeysPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeAccessKeys.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeAccessKeysPaginator) NextPage(ctx context.Context) (*DescribeAccessKeysOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeAccessKeysWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.AccessKeySet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeAccessKeysAll returns the AccessKeySet of all the pages of DescribeAccessKeys
+// starting at the Offset of input.
+func (s *AccesskeyService) DescribeAccessKeysAll(ctx context.Context, i *DescribeAccessKeysInput) ([]*AccessKey, error) {
+	p := NewDescribeAccessKeysPaginator(s, i)
+	set := []*AccessKey{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.AccessKeySet...)
+	}
+	return set, nil
+}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type AppService struct {
 	Config     *config.Config
 	Properties *AppServiceProperties
+	Handlers   *request.Handlers
 }
 
 type AppServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) App(zone string) (*AppService, error) {
 		Zone: &zone,
 	}
 
-	return &AppService{Config: s.Config, Properties: properties}, nil
+	return &AppService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/bot/DeployAppVersion.html
 func (s *AppService) DeployAppVersion(i *DeployAppVersionInput) (*DeployAppVersionOutput, error) {
+	return s.DeployAppVersionWithContext(context.Background(), i)
+}
+
+// DeployAppVersionWithContext is the same as DeployAppVersion with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *AppService) DeployAppVersionWithContext(ctx context.Context, i *DeployAppVersionInput) (*DeployAppVersionOutput, error) {
 	if i == nil {
 		i = &DeployAppVersionInput{}
 	}
@@ -64,8 +72,12 @@ func (s *AppService) DeployAppVersion(i *DeployAppVersionInput) (*DeployAppVersi
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +116,12 @@ type DeployAppVersionOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/bot/describe_app_version_attachments.html
 func (s *AppService) DescribeAppVersionAttachments(i *DescribeAppVersionAttachmentsInput) (*DescribeAppVersionAttachmentsOutput, error) {
+	return s.DescribeAppVersionAttachmentsWithContext(context.Background(), i)
+}
+
+// DescribeAppVersionAttachmentsWithContext is the same as DescribeAppVersionAttachments with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *AppService) DescribeAppVersionAttachmentsWithContext(ctx context.Context, i *DescribeAppVersionAttachmentsInput) (*DescribeAppVersionAttachmentsOutput, error) {
 	if i == nil {
 		i = &DescribeAppVersionAttachmentsInput{}
 	}
@@ -119,8 +137,12 @@ func (s *AppService) DescribeAppVersionAttachments(i *DescribeAppVersionAttachme
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +172,12 @@ type DescribeAppVersionAttachmentsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/bot/describe_app_versions.html
 func (s *AppService) DescribeAppVersions(i *DescribeAppVersionsInput) (*DescribeAppVersionsOutput, error) {
+	return s.DescribeAppVersionsWithContext(context.Background(), i)
+}
+
+// DescribeAppVersionsWithContext is the same as DescribeAppVersions with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *AppService) DescribeAppVersionsWithContext(ctx context.Context, i *DescribeAppVersionsInput) (*DescribeAppVersionsOutput, error) {
 	if i == nil {
 		i = &DescribeAppVersionsInput{}
 	}
@@ -165,8 +193,12 @@ func (s *AppService) DescribeAppVersions(i *DescribeAppVersionsInput) (*Describe
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -221,8 +253,67 @@ type DescribeAppVersionsOutput struct {
 	VersionSet []*AppVersion `json:"version_set" name:"version_set" location:"elements"`
 }
 
+// DescribeAppVersionsPaginator pages through the results of DescribeAppVersions.
+type DescribeAppVersionsPaginator struct {
+	request.Pagination
+	service *AppService
+	input   DescribeAppVersionsInput
+}
+
+// NewDescribeAppVersionsPaginator create a paginator of DescribeAppVersions starting at
+// the Offset of input.
+func NewDescribeAppVersionsPaginator(s *AppService, i *DescribeAppVersionsInput) *DescribeAppVersionsPaginator {
+	if i == nil {
+		i = &DescribeAppVersionsInput{}
+	}
+	return &DescribeAppVersionsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeAppVersions.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeAppVersionsPaginator) NextPage(ctx context.Context) (*DescribeAppVersionsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeAppVersionsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.VersionSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeAppVersionsAll returns the VersionSet of all the pages of DescribeAppVersions
+// starting at the Offset of input.
+func (s *AppService) DescribeAppVersionsAll(ctx context.Context, i *DescribeAppVersionsInput) ([]*AppVersion, error) {
+	p := NewDescribeAppVersionsPaginator(s, i)
+	set := []*AppVersion{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.VersionSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/bot/describe_apps.html
 func (s *AppService) DescribeApps(i *DescribeAppsInput) (*DescribeAppsOutput, error) {
+	return s.DescribeAppsWithContext(context.Background(), i)
+}
+
+// DescribeAppsWithContext is the same as DescribeApps with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *AppService) DescribeAppsWithContext(ctx context.Context, i *DescribeAppsInput) (*DescribeAppsOutput, error) {
 	if i == nil {
 		i = &DescribeAppsInput{}
 	}
@@ -238,8 +329,12 @@ func (s *AppService) DescribeApps(i *DescribeAppsInput) (*DescribeAppsOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -295,8 +390,67 @@ type DescribeAppsOutput struct {
 	TotalCount *int    `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeAppsPaginator pages through the results of DescribeApps.
+type DescribeAppsPaginator struct {
+	request.Pagination
+	service *AppService
+	input   DescribeAppsInput
+}
+
+// NewDescribeAppsPaginator create a paginator of DescribeApps starting at
+// the Offset of input.
+func NewDescribeAppsPaginator(s *AppService, i *DescribeAppsInput) *DescribeAppsPaginator {
+	if i == nil {
+		i = &DescribeAppsInput{}
+	}
+	return &DescribeAppsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeApps.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeAppsPaginator) NextPage(ctx context.Context) (*DescribeAppsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeAppsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.AppSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeAppsAll returns the AppSet of all the pages of DescribeApps
+// starting at the Offset of input.
+func (s *AppService) DescribeAppsAll(ctx context.Context, i *DescribeAppsInput) ([]*App, error) {
+	p := NewDescribeAppsPaginator(s, i)
+	set := []*App{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.AppSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/bot/describe_app_version_attachments.html
 func (s *AppService) GetGlobalUniqueId(i *GetGlobalUniqueIdInput) (*GetGlobalUniqueIdOutput, error) {
+	return s.GetGlobalUniqueIdWithContext(context.Background(), i)
+}
+
+// GetGlobalUniqueIdWithContext is the same as GetGlobalUniqueId with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *AppService) GetGlobalUniqueIdWithContext(ctx context.Context, i *GetGlobalUniqueIdInput) (*GetGlobalUniqueIdOutput, error) {
 	if i == nil {
 		i = &GetGlobalUniqueIdInput{}
 	}
@@ -312,8 +466,12 @@ func (s *AppService) GetGlobalUniqueId(i *GetGlobalUniqueIdInput) (*GetGlobalUni
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type CacheService struct {
 	Config     *config.Config
 	Properties *CacheServiceProperties
+	Handlers   *request.Handlers
 }
 
 type CacheServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Cache(zone string) (*CacheService, error) {
 		Zone: &zone,
 	}
 
-	return &CacheService{Config: s.Config, Properties: properties}, nil
+	return &CacheService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/add_cache_nodes.html
 func (s *CacheService) AddCacheNodes(i *AddCacheNodesInput) (*AddCacheNodesOutput, error) {
+	return s.AddCacheNodesWithContext(context.Background(), i)
+}
+
+// AddCacheNodesWithContext is the same as AddCacheNodes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) AddCacheNodesWithContext(ctx context.Context, i *AddCacheNodesInput) (*AddCacheNodesOutput, error) {
 	if i == nil {
 		i = &AddCacheNodesInput{}
 	}
@@ -64,8 +72,12 @@ func (s *CacheService) AddCacheNodes(i *AddCacheNodesInput) (*AddCacheNodesOutpu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +128,12 @@ type AddCacheNodesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/apply_cache_parameter_group.html
 func (s *CacheService) ApplyCacheParameterGroup(i *ApplyCacheParameterGroupInput) (*ApplyCacheParameterGroupOutput, error) {
+	return s.ApplyCacheParameterGroupWithContext(context.Background(), i)
+}
+
+// ApplyCacheParameterGroupWithContext is the same as ApplyCacheParameterGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) ApplyCacheParameterGroupWithContext(ctx context.Context, i *ApplyCacheParameterGroupInput) (*ApplyCacheParameterGroupOutput, error) {
 	if i == nil {
 		i = &ApplyCacheParameterGroupInput{}
 	}
@@ -131,8 +149,12 @@ func (s *CacheService) ApplyCacheParameterGroup(i *ApplyCacheParameterGroupInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +188,12 @@ type ApplyCacheParameterGroupOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/change_cache_vxnet.html
 func (s *CacheService) ChangeCacheVxNet(i *ChangeCacheVxNetInput) (*ChangeCacheVxNetOutput, error) {
+	return s.ChangeCacheVxNetWithContext(context.Background(), i)
+}
+
+// ChangeCacheVxNetWithContext is the same as ChangeCacheVxNet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) ChangeCacheVxNetWithContext(ctx context.Context, i *ChangeCacheVxNetInput) (*ChangeCacheVxNetOutput, error) {
 	if i == nil {
 		i = &ChangeCacheVxNetInput{}
 	}
@@ -181,8 +209,12 @@ func (s *CacheService) ChangeCacheVxNet(i *ChangeCacheVxNetInput) (*ChangeCacheV
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -234,6 +266,12 @@ type ChangeCacheVxNetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/create_cache.html
 func (s *CacheService) CreateCache(i *CreateCacheInput) (*CreateCacheOutput, error) {
+	return s.CreateCacheWithContext(context.Background(), i)
+}
+
+// CreateCacheWithContext is the same as CreateCache with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) CreateCacheWithContext(ctx context.Context, i *CreateCacheInput) (*CreateCacheOutput, error) {
 	if i == nil {
 		i = &CreateCacheInput{}
 	}
@@ -249,8 +287,12 @@ func (s *CacheService) CreateCache(i *CreateCacheInput) (*CreateCacheOutput, err
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +381,12 @@ type CreateCacheOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/create_cache_from_snapshot.html
 func (s *CacheService) CreateCacheFromSnapshot(i *CreateCacheFromSnapshotInput) (*CreateCacheFromSnapshotOutput, error) {
+	return s.CreateCacheFromSnapshotWithContext(context.Background(), i)
+}
+
+// CreateCacheFromSnapshotWithContext is the same as CreateCacheFromSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) CreateCacheFromSnapshotWithContext(ctx context.Context, i *CreateCacheFromSnapshotInput) (*CreateCacheFromSnapshotOutput, error) {
 	if i == nil {
 		i = &CreateCacheFromSnapshotInput{}
 	}
@@ -354,8 +402,12 @@ func (s *CacheService) CreateCacheFromSnapshot(i *CreateCacheFromSnapshotInput)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -436,6 +488,12 @@ type CreateCacheFromSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/create_cache_parameter_group.html
 func (s *CacheService) CreateCacheParameterGroup(i *CreateCacheParameterGroupInput) (*CreateCacheParameterGroupOutput, error) {
+	return s.CreateCacheParameterGroupWithContext(context.Background(), i)
+}
+
+// CreateCacheParameterGroupWithContext is the same as CreateCacheParameterGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) CreateCacheParameterGroupWithContext(ctx context.Context, i *CreateCacheParameterGroupInput) (*CreateCacheParameterGroupOutput, error) {
 	if i == nil {
 		i = &CreateCacheParameterGroupInput{}
 	}
@@ -451,8 +509,12 @@ func (s *CacheService) CreateCacheParameterGroup(i *CreateCacheParameterGroupInp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -507,6 +569,12 @@ type CreateCacheParameterGroupOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/delete_cache_nodes.html
 func (s *CacheService) DeleteCacheNodes(i *DeleteCacheNodesInput) (*DeleteCacheNodesOutput, error) {
+	return s.DeleteCacheNodesWithContext(context.Background(), i)
+}
+
+// DeleteCacheNodesWithContext is the same as DeleteCacheNodes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) DeleteCacheNodesWithContext(ctx context.Context, i *DeleteCacheNodesInput) (*DeleteCacheNodesOutput, error) {
 	if i == nil {
 		i = &DeleteCacheNodesInput{}
 	}
@@ -522,8 +590,12 @@ func (s *CacheService) DeleteCacheNodes(i *DeleteCacheNodesInput) (*DeleteCacheN
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -565,6 +637,12 @@ type DeleteCacheNodesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/delete_cache_parameter_groups.html
 func (s *CacheService) DeleteCacheParameterGroups(i *DeleteCacheParameterGroupsInput) (*DeleteCacheParameterGroupsOutput, error) {
+	return s.DeleteCacheParameterGroupsWithContext(context.Background(), i)
+}
+
+// DeleteCacheParameterGroupsWithContext is the same as DeleteCacheParameterGroups with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) DeleteCacheParameterGroupsWithContext(ctx context.Context, i *DeleteCacheParameterGroupsInput) (*DeleteCacheParameterGroupsOutput, error) {
 	if i == nil {
 		i = &DeleteCacheParameterGroupsInput{}
 	}
@@ -580,8 +658,12 @@ func (s *CacheService) DeleteCacheParameterGroups(i *DeleteCacheParameterGroupsI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -614,6 +696,12 @@ type DeleteCacheParameterGroupsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/delete_caches.html
 func (s *CacheService) DeleteCaches(i *DeleteCachesInput) (*DeleteCachesOutput, error) {
+	return s.DeleteCachesWithContext(context.Background(), i)
+}
+
+// DeleteCachesWithContext is the same as DeleteCaches with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) DeleteCachesWithContext(ctx context.Context, i *DeleteCachesInput) (*DeleteCachesOutput, error) {
 	if i == nil {
 		i = &DeleteCachesInput{}
 	}
@@ -629,8 +717,12 @@ func (s *CacheService) DeleteCaches(i *DeleteCachesInput) (*DeleteCachesOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -664,6 +756,12 @@ type DeleteCachesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/describe_cache_nodes.html
 func (s *CacheService) DescribeCacheNodes(i *DescribeCacheNodesInput) (*DescribeCacheNodesOutput, error) {
+	return s.DescribeCacheNodesWithContext(context.Background(), i)
+}
+
+// DescribeCacheNodesWithContext is the same as DescribeCacheNodes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) DescribeCacheNodesWithContext(ctx context.Context, i *DescribeCacheNodesInput) (*DescribeCacheNodesOutput, error) {
 	if i == nil {
 		i = &DescribeCacheNodesInput{}
 	}
@@ -679,8 +777,12 @@ func (s *CacheService) DescribeCacheNodes(i *DescribeCacheNodesInput) (*Describe
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -711,8 +813,99 @@ type DescribeCacheNodesOutput struct {
 	TotalCount   *int         `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeCacheNodesPaginator pages through the results of DescribeCacheNodes.
+type DescribeCacheNodesPaginator struct {
+	request.Pagination
+	service *CacheService
+	input   DescribeCacheNodesInput
+}
+
+// NewDescribeCacheNodesPaginator create a paginator of DescribeCacheNodes starting at
+// the Offset of input.
+func NewDescribeCacheNodesPaginator(s *CacheService, i *DescribeCacheNodesInput) *DescribeCacheNodesPaginator {
+	if i == nil {
+		i = &DescribeCacheNodesInput{}
+	}
+	return &DescribeCacheNodesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeCacheNodes.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeCacheNodesPaginator) NextPage(ctx context.Context) (*DescribeCacheNodesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeCacheNodesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.CacheNodeSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeCacheNodesAll returns the CacheNodeSet of all the pages of DescribeCacheNodes
+// starting at the Offset of input.
+func (s *CacheService) DescribeCacheNodesAll(ctx context.Context, i *DescribeCacheNodesInput) ([]*CacheNode, error) {
+	p := NewDescribeCacheNodesPaginator(s, i)
+	set := []*CacheNode{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.CacheNodeSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilCacheNodesStatus waits until all the given CacheNodes are in the
+// status without transition status, it fails if any of them is not found.
+func (s *CacheService) WaitUntilCacheNodesStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*CacheNode, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilCacheNodesStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeCacheNodesAll(ctx, &DescribeCacheNodesInput{
+				CacheNodes: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeCacheNodesOutput{CacheNodeSet: set}, nil
+		},
+		StatusPath:           "CacheNodeSet[].Status",
+		TransitionStatusPath: "CacheNodeSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeCacheNodesOutput).CacheNodeSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/cache/describe_cache_parameter_groups.html
 func (s *CacheService) DescribeCacheParameterGroups(i *DescribeCacheParameterGroupsInput) (*DescribeCacheParameterGroupsOutput, error) {
+	return s.DescribeCacheParameterGroupsWithContext(context.Background(), i)
+}
+
+// DescribeCacheParameterGroupsWithContext is the same as DescribeCacheParameterGroups with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) DescribeCacheParameterGroupsWithContext(ctx context.Context, i *DescribeCacheParameterGroupsInput) (*DescribeCacheParameterGroupsOutput, error) {
 	if i == nil {
 		i = &DescribeCacheParameterGroupsInput{}
 	}
@@ -728,8 +921,12 @@ func (s *CacheService) DescribeCacheParameterGroups(i *DescribeCacheParameterGro
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -759,8 +956,67 @@ type DescribeCacheParameterGroupsOutput struct {
 	TotalCount             *int                   `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeCacheParameterGroupsPaginator pages through the results of DescribeCacheParameterGroups.
+type DescribeCacheParameterGroupsPaginator struct {
+	request.Pagination
+	service *CacheService
+	input   DescribeCacheParameterGroupsInput
+}
+
+// NewDescribeCacheParameterGroupsPaginator create a paginator of DescribeCacheParameterGroups starting at
+// the Offset of input.
+func NewDescribeCacheParameterGroupsPaginator(s *CacheService, i *DescribeCacheParameterGroupsInput) *DescribeCacheParameterGroupsPaginator {
+	if i == nil {
+		i = &DescribeCacheParameterGroupsInput{}
+	}
+	return &DescribeCacheParameterGroupsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeCacheParameterGroups.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeCacheParameterGroupsPaginator) NextPage(ctx context.Context) (*DescribeCacheParameterGroupsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeCacheParameterGroupsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.CacheParameterGroupSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeCacheParameterGroupsAll returns the CacheParameterGroupSet of all the pages of DescribeCacheParameterGroups
+// starting at the Offset of input.
+func (s *CacheService) DescribeCacheParameterGroupsAll(ctx context.Context, i *DescribeCacheParameterGroupsInput) ([]*CacheParameterGroup, error) {
+	p := NewDescribeCacheParameterGroupsPaginator(s, i)
+	set := []*CacheParameterGroup{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.CacheParameterGroupSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/cache/describe_cache_parameters.html
 func (s *CacheService) DescribeCacheParameters(i *DescribeCacheParametersInput) (*DescribeCacheParametersOutput, error) {
+	return s.DescribeCacheParametersWithContext(context.Background(), i)
+}
+
+// DescribeCacheParametersWithContext is the same as DescribeCacheParameters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) DescribeCacheParametersWithContext(ctx context.Context, i *DescribeCacheParametersInput) (*DescribeCacheParametersOutput, error) {
 	if i == nil {
 		i = &DescribeCacheParametersInput{}
 	}
@@ -776,8 +1032,12 @@ func (s *CacheService) DescribeCacheParameters(i *DescribeCacheParametersInput)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -812,6 +1072,12 @@ type DescribeCacheParametersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/describe_caches.html
 func (s *CacheService) DescribeCaches(i *DescribeCachesInput) (*DescribeCachesOutput, error) {
+	return s.DescribeCachesWithContext(context.Background(), i)
+}
+
+// DescribeCachesWithContext is the same as DescribeCaches with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) DescribeCachesWithContext(ctx context.Context, i *DescribeCachesInput) (*DescribeCachesOutput, error) {
 	if i == nil {
 		i = &DescribeCachesInput{}
 	}
@@ -827,8 +1093,12 @@ func (s *CacheService) DescribeCaches(i *DescribeCachesInput) (*DescribeCachesOu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -861,8 +1131,99 @@ type DescribeCachesOutput struct {
 	TotalCount *int     `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeCachesPaginator pages through the results of DescribeCaches.
+type DescribeCachesPaginator struct {
+	request.Pagination
+	service *CacheService
+	input   DescribeCachesInput
+}
+
+// NewDescribeCachesPaginator create a paginator of DescribeCaches starting at
+// the Offset of input.
+func NewDescribeCachesPaginator(s *CacheService, i *DescribeCachesInput) *DescribeCachesPaginator {
+	if i == nil {
+		i = &DescribeCachesInput{}
+	}
+	return &DescribeCachesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeCaches.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeCachesPaginator) NextPage(ctx context.Context) (*DescribeCachesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeCachesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.CacheSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeCachesAll returns the CacheSet of all the pages of DescribeCaches
+// starting at the Offset of input.
+func (s *CacheService) DescribeCachesAll(ctx context.Context, i *DescribeCachesInput) ([]*Cache, error) {
+	p := NewDescribeCachesPaginator(s, i)
+	set := []*Cache{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.CacheSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilCachesStatus waits until all the given Caches are in the
+// status without transition status, it fails if any of them is not found.
+func (s *CacheService) WaitUntilCachesStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*Cache, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilCachesStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeCachesAll(ctx, &DescribeCachesInput{
+				Caches: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeCachesOutput{CacheSet: set}, nil
+		},
+		StatusPath:           "CacheSet[].Status",
+		TransitionStatusPath: "CacheSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeCachesOutput).CacheSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/monitor/get_cache_monitor.html
 func (s *CacheService) GetCacheMonitor(i *GetCacheMonitorInput) (*GetCacheMonitorOutput, error) {
+	return s.GetCacheMonitorWithContext(context.Background(), i)
+}
+
+// GetCacheMonitorWithContext is the same as GetCacheMonitor with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) GetCacheMonitorWithContext(ctx context.Context, i *GetCacheMonitorInput) (*GetCacheMonitorOutput, error) {
 	if i == nil {
 		i = &GetCacheMonitorInput{}
 	}
@@ -878,8 +1239,12 @@ func (s *CacheService) GetCacheMonitor(i *GetCacheMonitorInput) (*GetCacheMonito
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -952,6 +1317,12 @@ type GetCacheMonitorOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/modify_cache_attributes.html
 func (s *CacheService) ModifyCacheAttributes(i *ModifyCacheAttributesInput) (*ModifyCacheAttributesOutput, error) {
+	return s.ModifyCacheAttributesWithContext(context.Background(), i)
+}
+
+// ModifyCacheAttributesWithContext is the same as ModifyCacheAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) ModifyCacheAttributesWithContext(ctx context.Context, i *ModifyCacheAttributesInput) (*ModifyCacheAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyCacheAttributesInput{}
 	}
@@ -967,8 +1338,12 @@ func (s *CacheService) ModifyCacheAttributes(i *ModifyCacheAttributesInput) (*Mo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1003,6 +1378,12 @@ type ModifyCacheAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/modify_cache_node_attributes.html
 func (s *CacheService) ModifyCacheNodeAttributes(i *ModifyCacheNodeAttributesInput) (*ModifyCacheNodeAttributesOutput, error) {
+	return s.ModifyCacheNodeAttributesWithContext(context.Background(), i)
+}
+
+// ModifyCacheNodeAttributesWithContext is the same as ModifyCacheNodeAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) ModifyCacheNodeAttributesWithContext(ctx context.Context, i *ModifyCacheNodeAttributesInput) (*ModifyCacheNodeAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyCacheNodeAttributesInput{}
 	}
@@ -1018,8 +1399,12 @@ func (s *CacheService) ModifyCacheNodeAttributes(i *ModifyCacheNodeAttributesInp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1052,6 +1437,12 @@ type ModifyCacheNodeAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/modify_cache_parameter_group_attributes.html
 func (s *CacheService) ModifyCacheParameterGroupAttributes(i *ModifyCacheParameterGroupAttributesInput) (*ModifyCacheParameterGroupAttributesOutput, error) {
+	return s.ModifyCacheParameterGroupAttributesWithContext(context.Background(), i)
+}
+
+// ModifyCacheParameterGroupAttributesWithContext is the same as ModifyCacheParameterGroupAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) ModifyCacheParameterGroupAttributesWithContext(ctx context.Context, i *ModifyCacheParameterGroupAttributesInput) (*ModifyCacheParameterGroupAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyCacheParameterGroupAttributesInput{}
 	}
@@ -1067,8 +1458,12 @@ func (s *CacheService) ModifyCacheParameterGroupAttributes(i *ModifyCacheParamet
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1103,6 +1498,12 @@ type ModifyCacheParameterGroupAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/reset_cache_parameters.html
 func (s *CacheService) ResetCacheParameters(i *ResetCacheParametersInput) (*ResetCacheParametersOutput, error) {
+	return s.ResetCacheParametersWithContext(context.Background(), i)
+}
+
+// ResetCacheParametersWithContext is the same as ResetCacheParameters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) ResetCacheParametersWithContext(ctx context.Context, i *ResetCacheParametersInput) (*ResetCacheParametersOutput, error) {
 	if i == nil {
 		i = &ResetCacheParametersInput{}
 	}
@@ -1118,8 +1519,12 @@ func (s *CacheService) ResetCacheParameters(i *ResetCacheParametersInput) (*Rese
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1152,6 +1557,12 @@ type ResetCacheParametersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/resize_cache.html
 func (s *CacheService) ResizeCaches(i *ResizeCachesInput) (*ResizeCachesOutput, error) {
+	return s.ResizeCachesWithContext(context.Background(), i)
+}
+
+// ResizeCachesWithContext is the same as ResizeCaches with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) ResizeCachesWithContext(ctx context.Context, i *ResizeCachesInput) (*ResizeCachesOutput, error) {
 	if i == nil {
 		i = &ResizeCachesInput{}
 	}
@@ -1167,8 +1578,12 @@ func (s *CacheService) ResizeCaches(i *ResizeCachesInput) (*ResizeCachesOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1209,6 +1624,12 @@ type ResizeCachesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/restart_cache_nodes.html
 func (s *CacheService) RestartCacheNodes(i *RestartCacheNodesInput) (*RestartCacheNodesOutput, error) {
+	return s.RestartCacheNodesWithContext(context.Background(), i)
+}
+
+// RestartCacheNodesWithContext is the same as RestartCacheNodes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) RestartCacheNodesWithContext(ctx context.Context, i *RestartCacheNodesInput) (*RestartCacheNodesOutput, error) {
 	if i == nil {
 		i = &RestartCacheNodesInput{}
 	}
@@ -1224,8 +1645,12 @@ func (s *CacheService) RestartCacheNodes(i *RestartCacheNodesInput) (*RestartCac
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1267,6 +1692,12 @@ type RestartCacheNodesOutput struct {
 // RestartCaches: Only available for memcached.
 // Documentation URL: https://docs.qingcloud.com/api/cache/restart_caches.html
 func (s *CacheService) RestartCaches(i *RestartCachesInput) (*RestartCachesOutput, error) {
+	return s.RestartCachesWithContext(context.Background(), i)
+}
+
+// RestartCachesWithContext is the same as RestartCaches with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) RestartCachesWithContext(ctx context.Context, i *RestartCachesInput) (*RestartCachesOutput, error) {
 	if i == nil {
 		i = &RestartCachesInput{}
 	}
@@ -1282,8 +1713,12 @@ func (s *CacheService) RestartCaches(i *RestartCachesInput) (*RestartCachesOutpu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1316,6 +1751,12 @@ type RestartCachesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/start_caches.html
 func (s *CacheService) StartCaches(i *StartCachesInput) (*StartCachesOutput, error) {
+	return s.StartCachesWithContext(context.Background(), i)
+}
+
+// StartCachesWithContext is the same as StartCaches with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) StartCachesWithContext(ctx context.Context, i *StartCachesInput) (*StartCachesOutput, error) {
 	if i == nil {
 		i = &StartCachesInput{}
 	}
@@ -1331,8 +1772,12 @@ func (s *CacheService) StartCaches(i *StartCachesInput) (*StartCachesOutput, err
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1366,6 +1811,12 @@ type StartCachesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/stop_caches.html
 func (s *CacheService) StopCaches(i *StopCachesInput) (*StopCachesOutput, error) {
+	return s.StopCachesWithContext(context.Background(), i)
+}
+
+// StopCachesWithContext is the same as StopCaches with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) StopCachesWithContext(ctx context.Context, i *StopCachesInput) (*StopCachesOutput, error) {
 	if i == nil {
 		i = &StopCachesInput{}
 	}
@@ -1381,8 +1832,12 @@ func (s *CacheService) StopCaches(i *StopCachesInput) (*StopCachesOutput, error)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1416,6 +1871,12 @@ type StopCachesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/update_cache.html
 func (s *CacheService) UpdateCache(i *UpdateCacheInput) (*UpdateCacheOutput, error) {
+	return s.UpdateCacheWithContext(context.Background(), i)
+}
+
+// UpdateCacheWithContext is the same as UpdateCache with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) UpdateCacheWithContext(ctx context.Context, i *UpdateCacheInput) (*UpdateCacheOutput, error) {
 	if i == nil {
 		i = &UpdateCacheInput{}
 	}
@@ -1431,8 +1892,12 @@ func (s *CacheService) UpdateCache(i *UpdateCacheInput) (*UpdateCacheOutput, err
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1474,6 +1939,12 @@ type UpdateCacheOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cache/update_cache_parameters.html
 func (s *CacheService) UpdateCacheParameters(i *UpdateCacheParametersInput) (*UpdateCacheParametersOutput, error) {
+	return s.UpdateCacheParametersWithContext(context.Background(), i)
+}
+
+// UpdateCacheParametersWithContext is the same as UpdateCacheParameters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *CacheService) UpdateCacheParametersWithContext(ctx context.Context, i *UpdateCacheParametersInput) (*UpdateCacheParametersOutput, error) {
 	if i == nil {
 		i = &UpdateCacheParametersInput{}
 	}
@@ -1489,8 +1960,12 @@ func (s *CacheService) UpdateCacheParameters(i *UpdateCacheParametersInput) (*Up
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type ClusterService struct {
 	Config     *config.Config
 	Properties *ClusterServiceProperties
+	Handlers   *request.Handlers
 }
 
 type ClusterServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Cluster(zone string) (*ClusterService, error) {
 		Zone: &zone,
 	}
 
-	return &ClusterService{Config: s.Config, Properties: properties}, nil
+	return &ClusterService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/add_cluster_nodes.html
 func (s *ClusterService) AddClusterNodes(i *AddClusterNodesInput) (*AddClusterNodesOutput, error) {
+	return s.AddClusterNodesWithContext(context.Background(), i)
+}
+
+// AddClusterNodesWithContext is the same as AddClusterNodes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) AddClusterNodesWithContext(ctx context.Context, i *AddClusterNodesInput) (*AddClusterNodesOutput, error) {
 	if i == nil {
 		i = &AddClusterNodesInput{}
 	}
@@ -64,8 +72,12 @@ func (s *ClusterService) AddClusterNodes(i *AddClusterNodesInput) (*AddClusterNo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +124,12 @@ type AddClusterNodesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/associate_eip_to_cluster_node.html
 func (s *ClusterService) AssociateEIPToClusterNode(i *AssociateEIPToClusterNodeInput) (*AssociateEIPToClusterNodeOutput, error) {
+	return s.AssociateEIPToClusterNodeWithContext(context.Background(), i)
+}
+
+// AssociateEIPToClusterNodeWithContext is the same as AssociateEIPToClusterNode with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) AssociateEIPToClusterNodeWithContext(ctx context.Context, i *AssociateEIPToClusterNodeInput) (*AssociateEIPToClusterNodeOutput, error) {
 	if i == nil {
 		i = &AssociateEIPToClusterNodeInput{}
 	}
@@ -127,8 +145,12 @@ func (s *ClusterService) AssociateEIPToClusterNode(i *AssociateEIPToClusterNodeI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +192,12 @@ type AssociateEIPToClusterNodeOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/cease_clusters.html
 func (s *ClusterService) CeaseClusters(i *CeaseClustersInput) (*CeaseClustersOutput, error) {
+	return s.CeaseClustersWithContext(context.Background(), i)
+}
+
+// CeaseClustersWithContext is the same as CeaseClusters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) CeaseClustersWithContext(ctx context.Context, i *CeaseClustersInput) (*CeaseClustersOutput, error) {
 	if i == nil {
 		i = &CeaseClustersInput{}
 	}
@@ -185,8 +213,12 @@ func (s *ClusterService) CeaseClusters(i *CeaseClustersInput) (*CeaseClustersOut
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +251,12 @@ type CeaseClustersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/change_cluster_vxnet.html
 func (s *ClusterService) ChangeClusterVxNet(i *ChangeClusterVxNetInput) (*ChangeClusterVxNetOutput, error) {
+	return s.ChangeClusterVxNetWithContext(context.Background(), i)
+}
+
+// ChangeClusterVxNetWithContext is the same as ChangeClusterVxNet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) ChangeClusterVxNetWithContext(ctx context.Context, i *ChangeClusterVxNetInput) (*ChangeClusterVxNetOutput, error) {
 	if i == nil {
 		i = &ChangeClusterVxNetInput{}
 	}
@@ -234,8 +272,12 @@ func (s *ClusterService) ChangeClusterVxNet(i *ChangeClusterVxNetInput) (*Change
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -280,6 +322,12 @@ type ChangeClusterVxNetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/create_cluster.html
 func (s *ClusterService) CreateCluster(i *CreateClusterInput) (*CreateClusterOutput, error) {
+	return s.CreateClusterWithContext(context.Background(), i)
+}
+
+// CreateClusterWithContext is the same as CreateCluster with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) CreateClusterWithContext(ctx context.Context, i *CreateClusterInput) (*CreateClusterOutput, error) {
 	if i == nil {
 		i = &CreateClusterInput{}
 	}
@@ -295,8 +343,12 @@ func (s *ClusterService) CreateCluster(i *CreateClusterInput) (*CreateClusterOut
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -335,6 +387,12 @@ type CreateClusterOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/create_cluster_from_snapshot.html
 func (s *ClusterService) CreateClusterFromSnapshot(i *CreateClusterFromSnapshotInput) (*CreateClusterFromSnapshotOutput, error) {
+	return s.CreateClusterFromSnapshotWithContext(context.Background(), i)
+}
+
+// CreateClusterFromSnapshotWithContext is the same as CreateClusterFromSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) CreateClusterFromSnapshotWithContext(ctx context.Context, i *CreateClusterFromSnapshotInput) (*CreateClusterFromSnapshotOutput, error) {
 	if i == nil {
 		i = &CreateClusterFromSnapshotInput{}
 	}
@@ -350,8 +408,12 @@ func (s *ClusterService) CreateClusterFromSnapshot(i *CreateClusterFromSnapshotI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -398,6 +460,12 @@ type CreateClusterFromSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/delete_cluster_nodes.html
 func (s *ClusterService) DeleteClusterNodes(i *DeleteClusterNodesInput) (*DeleteClusterNodesOutput, error) {
+	return s.DeleteClusterNodesWithContext(context.Background(), i)
+}
+
+// DeleteClusterNodesWithContext is the same as DeleteClusterNodes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) DeleteClusterNodesWithContext(ctx context.Context, i *DeleteClusterNodesInput) (*DeleteClusterNodesOutput, error) {
 	if i == nil {
 		i = &DeleteClusterNodesInput{}
 	}
@@ -413,8 +481,12 @@ func (s *ClusterService) DeleteClusterNodes(i *DeleteClusterNodesInput) (*Delete
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -458,6 +530,12 @@ type DeleteClusterNodesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/delete_clusters.html
 func (s *ClusterService) DeleteClusters(i *DeleteClustersInput) (*DeleteClustersOutput, error) {
+	return s.DeleteClustersWithContext(context.Background(), i)
+}
+
+// DeleteClustersWithContext is the same as DeleteClusters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) DeleteClustersWithContext(ctx context.Context, i *DeleteClustersInput) (*DeleteClustersOutput, error) {
 	if i == nil {
 		i = &DeleteClustersInput{}
 	}
@@ -473,8 +551,12 @@ func (s *ClusterService) DeleteClusters(i *DeleteClustersInput) (*DeleteClusters
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -508,6 +590,12 @@ type DeleteClustersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/describe_cluster_display_tabs.html
 func (s *ClusterService) DescribeClusterDisplayTabs(i *DescribeClusterDisplayTabsInput) (*DescribeClusterDisplayTabsOutput, error) {
+	return s.DescribeClusterDisplayTabsWithContext(context.Background(), i)
+}
+
+// DescribeClusterDisplayTabsWithContext is the same as DescribeClusterDisplayTabs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) DescribeClusterDisplayTabsWithContext(ctx context.Context, i *DescribeClusterDisplayTabsInput) (*DescribeClusterDisplayTabsOutput, error) {
 	if i == nil {
 		i = &DescribeClusterDisplayTabsInput{}
 	}
@@ -523,8 +611,12 @@ func (s *ClusterService) DescribeClusterDisplayTabs(i *DescribeClusterDisplayTab
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -566,6 +658,12 @@ type DescribeClusterDisplayTabsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/describe_cluster_nodes.html
 func (s *ClusterService) DescribeClusterNodes(i *DescribeClusterNodesInput) (*DescribeClusterNodesOutput, error) {
+	return s.DescribeClusterNodesWithContext(context.Background(), i)
+}
+
+// DescribeClusterNodesWithContext is the same as DescribeClusterNodes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) DescribeClusterNodesWithContext(ctx context.Context, i *DescribeClusterNodesInput) (*DescribeClusterNodesOutput, error) {
 	if i == nil {
 		i = &DescribeClusterNodesInput{}
 	}
@@ -581,8 +679,12 @@ func (s *ClusterService) DescribeClusterNodes(i *DescribeClusterNodesInput) (*De
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -618,8 +720,99 @@ type DescribeClusterNodesOutput struct {
 	TotalCount *int           `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeClusterNodesPaginator pages through the results of DescribeClusterNodes.
+type DescribeClusterNodesPaginator struct {
+	request.Pagination
+	service *ClusterService
+	input   DescribeClusterNodesInput
+}
+
+// NewDescribeClusterNodesPaginator create a paginator of DescribeClusterNodes starting at
+// the Offset of input.
+func NewDescribeClusterNodesPaginator(s *ClusterService, i *DescribeClusterNodesInput) *DescribeClusterNodesPaginator {
+	if i == nil {
+		i = &DescribeClusterNodesInput{}
+	}
+	return &DescribeClusterNodesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeClusterNodes.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeClusterNodesPaginator) NextPage(ctx context.Context) (*DescribeClusterNodesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeClusterNodesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.NodeSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeClusterNodesAll returns the NodeSet of all the pages of DescribeClusterNodes
+// starting at the Offset of input.
+func (s *ClusterService) DescribeClusterNodesAll(ctx context.Context, i *DescribeClusterNodesInput) ([]*ClusterNode, error) {
+	p := NewDescribeClusterNodesPaginator(s, i)
+	set := []*ClusterNode{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.NodeSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilClusterNodesStatus waits until all the given ClusterNodes are in the
+// status without transition status, it fails if any of them is not found.
+func (s *ClusterService) WaitUntilClusterNodesStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*ClusterNode, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilClusterNodesStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeClusterNodesAll(ctx, &DescribeClusterNodesInput{
+				Nodes: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeClusterNodesOutput{NodeSet: set}, nil
+		},
+		StatusPath:           "NodeSet[].Status",
+		TransitionStatusPath: "NodeSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeClusterNodesOutput).NodeSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/cluster/describe_cluster_users.html
 func (s *ClusterService) DescribeClusterUsers(i *DescribeClusterUsersInput) (*DescribeClusterUsersOutput, error) {
+	return s.DescribeClusterUsersWithContext(context.Background(), i)
+}
+
+// DescribeClusterUsersWithContext is the same as DescribeClusterUsers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) DescribeClusterUsersWithContext(ctx context.Context, i *DescribeClusterUsersInput) (*DescribeClusterUsersOutput, error) {
 	if i == nil {
 		i = &DescribeClusterUsersInput{}
 	}
@@ -635,8 +828,12 @@ func (s *ClusterService) DescribeClusterUsers(i *DescribeClusterUsersInput) (*De
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -683,6 +880,12 @@ type DescribeClusterUsersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/describe_clusters.html
 func (s *ClusterService) DescribeClusters(i *DescribeClustersInput) (*DescribeClustersOutput, error) {
+	return s.DescribeClustersWithContext(context.Background(), i)
+}
+
+// DescribeClustersWithContext is the same as DescribeClusters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) DescribeClustersWithContext(ctx context.Context, i *DescribeClustersInput) (*DescribeClustersOutput, error) {
 	if i == nil {
 		i = &DescribeClustersInput{}
 	}
@@ -698,8 +901,12 @@ func (s *ClusterService) DescribeClusters(i *DescribeClustersInput) (*DescribeCl
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -765,8 +972,99 @@ type DescribeClustersOutput struct {
 	TotalCount *int       `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeClustersPaginator pages through the results of DescribeClusters.
+type DescribeClustersPaginator struct {
+	request.Pagination
+	service *ClusterService
+	input   DescribeClustersInput
+}
+
+// NewDescribeClustersPaginator create a paginator of DescribeClusters starting at
+// the Offset of input.
+func NewDescribeClustersPaginator(s *ClusterService, i *DescribeClustersInput) *DescribeClustersPaginator {
+	if i == nil {
+		i = &DescribeClustersInput{}
+	}
+	return &DescribeClustersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeClusters.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeClustersPaginator) NextPage(ctx context.Context) (*DescribeClustersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeClustersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.ClusterSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeClustersAll returns the ClusterSet of all the pages of DescribeClusters
+// starting at the Offset of input.
+func (s *ClusterService) DescribeClustersAll(ctx context.Context, i *DescribeClustersInput) ([]*Cluster, error) {
+	p := NewDescribeClustersPaginator(s, i)
+	set := []*Cluster{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.ClusterSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilClustersStatus waits until all the given Clusters are in the
+// status without transition status, it fails if any of them is not found.
+func (s *ClusterService) WaitUntilClustersStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*Cluster, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilClustersStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeClustersAll(ctx, &DescribeClustersInput{
+				Clusters: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeClustersOutput{ClusterSet: set}, nil
+		},
+		StatusPath:           "ClusterSet[].Status",
+		TransitionStatusPath: "ClusterSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeClustersOutput).ClusterSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/cluster/dissociate_eip_from_cluster_node.html
 func (s *ClusterService) DissociateEIPFromClusterNode(i *DissociateEIPFromClusterNodeInput) (*DissociateEIPFromClusterNodeOutput, error) {
+	return s.DissociateEIPFromClusterNodeWithContext(context.Background(), i)
+}
+
+// DissociateEIPFromClusterNodeWithContext is the same as DissociateEIPFromClusterNode with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) DissociateEIPFromClusterNodeWithContext(ctx context.Context, i *DissociateEIPFromClusterNodeInput) (*DissociateEIPFromClusterNodeOutput, error) {
 	if i == nil {
 		i = &DissociateEIPFromClusterNodeInput{}
 	}
@@ -782,8 +1080,12 @@ func (s *ClusterService) DissociateEIPFromClusterNode(i *DissociateEIPFromCluste
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -816,6 +1118,12 @@ type DissociateEIPFromClusterNodeOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/modify_cluster_attributes.html
 func (s *ClusterService) ModifyClusterAttributes(i *ModifyClusterAttributesInput) (*ModifyClusterAttributesOutput, error) {
+	return s.ModifyClusterAttributesWithContext(context.Background(), i)
+}
+
+// ModifyClusterAttributesWithContext is the same as ModifyClusterAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) ModifyClusterAttributesWithContext(ctx context.Context, i *ModifyClusterAttributesInput) (*ModifyClusterAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyClusterAttributesInput{}
 	}
@@ -831,8 +1139,12 @@ func (s *ClusterService) ModifyClusterAttributes(i *ModifyClusterAttributesInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -867,6 +1179,12 @@ type ModifyClusterAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/modify_cluster_node_attributes.html
 func (s *ClusterService) ModifyClusterNodeAttributes(i *ModifyClusterNodeAttributesInput) (*ModifyClusterNodeAttributesOutput, error) {
+	return s.ModifyClusterNodeAttributesWithContext(context.Background(), i)
+}
+
+// ModifyClusterNodeAttributesWithContext is the same as ModifyClusterNodeAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) ModifyClusterNodeAttributesWithContext(ctx context.Context, i *ModifyClusterNodeAttributesInput) (*ModifyClusterNodeAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyClusterNodeAttributesInput{}
 	}
@@ -882,8 +1200,12 @@ func (s *ClusterService) ModifyClusterNodeAttributes(i *ModifyClusterNodeAttribu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -916,6 +1238,12 @@ type ModifyClusterNodeAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/recover_clusters.html
 func (s *ClusterService) RecoverClusters(i *RecoverClustersInput) (*RecoverClustersOutput, error) {
+	return s.RecoverClustersWithContext(context.Background(), i)
+}
+
+// RecoverClustersWithContext is the same as RecoverClusters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) RecoverClustersWithContext(ctx context.Context, i *RecoverClustersInput) (*RecoverClustersOutput, error) {
 	if i == nil {
 		i = &RecoverClustersInput{}
 	}
@@ -931,8 +1259,12 @@ func (s *ClusterService) RecoverClusters(i *RecoverClustersInput) (*RecoverClust
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -957,6 +1289,12 @@ type RecoverClustersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/resize_cluster.html
 func (s *ClusterService) ResizeCluster(i *ResizeClusterInput) (*ResizeClusterOutput, error) {
+	return s.ResizeClusterWithContext(context.Background(), i)
+}
+
+// ResizeClusterWithContext is the same as ResizeCluster with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) ResizeClusterWithContext(ctx context.Context, i *ResizeClusterInput) (*ResizeClusterOutput, error) {
 	if i == nil {
 		i = &ResizeClusterInput{}
 	}
@@ -972,8 +1310,12 @@ func (s *ClusterService) ResizeCluster(i *ResizeClusterInput) (*ResizeClusterOut
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1017,6 +1359,12 @@ type ResizeClusterOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/restart_cluster_service.html
 func (s *ClusterService) RestartClusterService(i *RestartClusterServiceInput) (*RestartClusterServiceOutput, error) {
+	return s.RestartClusterServiceWithContext(context.Background(), i)
+}
+
+// RestartClusterServiceWithContext is the same as RestartClusterService with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) RestartClusterServiceWithContext(ctx context.Context, i *RestartClusterServiceInput) (*RestartClusterServiceOutput, error) {
 	if i == nil {
 		i = &RestartClusterServiceInput{}
 	}
@@ -1032,8 +1380,12 @@ func (s *ClusterService) RestartClusterService(i *RestartClusterServiceInput) (*
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1062,6 +1414,12 @@ type RestartClusterServiceOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/restore_cluster_from_snapshot.html
 func (s *ClusterService) RestoreClusterFromSnapshot(i *RestoreClusterFromSnapshotInput) (*RestoreClusterFromSnapshotOutput, error) {
+	return s.RestoreClusterFromSnapshotWithContext(context.Background(), i)
+}
+
+// RestoreClusterFromSnapshotWithContext is the same as RestoreClusterFromSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) RestoreClusterFromSnapshotWithContext(ctx context.Context, i *RestoreClusterFromSnapshotInput) (*RestoreClusterFromSnapshotOutput, error) {
 	if i == nil {
 		i = &RestoreClusterFromSnapshotInput{}
 	}
@@ -1077,8 +1435,12 @@ func (s *ClusterService) RestoreClusterFromSnapshot(i *RestoreClusterFromSnapsho
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1123,6 +1485,12 @@ type RestoreClusterFromSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/run_cluster_custom_service.html
 func (s *ClusterService) RunClusterCustomService(i *RunClusterCustomServiceInput) (*RunClusterCustomServiceOutput, error) {
+	return s.RunClusterCustomServiceWithContext(context.Background(), i)
+}
+
+// RunClusterCustomServiceWithContext is the same as RunClusterCustomService with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) RunClusterCustomServiceWithContext(ctx context.Context, i *RunClusterCustomServiceInput) (*RunClusterCustomServiceOutput, error) {
 	if i == nil {
 		i = &RunClusterCustomServiceInput{}
 	}
@@ -1138,8 +1506,12 @@ func (s *ClusterService) RunClusterCustomService(i *RunClusterCustomServiceInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1185,6 +1557,12 @@ type RunClusterCustomServiceOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/start_clusters.html
 func (s *ClusterService) StartClusters(i *StartClustersInput) (*StartClustersOutput, error) {
+	return s.StartClustersWithContext(context.Background(), i)
+}
+
+// StartClustersWithContext is the same as StartClusters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) StartClustersWithContext(ctx context.Context, i *StartClustersInput) (*StartClustersOutput, error) {
 	if i == nil {
 		i = &StartClustersInput{}
 	}
@@ -1200,8 +1578,12 @@ func (s *ClusterService) StartClusters(i *StartClustersInput) (*StartClustersOut
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1234,6 +1616,12 @@ type StartClustersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/stop_clusters.html
 func (s *ClusterService) StopClusters(i *StopClustersInput) (*StopClustersOutput, error) {
+	return s.StopClustersWithContext(context.Background(), i)
+}
+
+// StopClustersWithContext is the same as StopClusters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) StopClustersWithContext(ctx context.Context, i *StopClustersInput) (*StopClustersOutput, error) {
 	if i == nil {
 		i = &StopClustersInput{}
 	}
@@ -1249,8 +1637,12 @@ func (s *ClusterService) StopClusters(i *StopClustersInput) (*StopClustersOutput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1284,6 +1676,12 @@ type StopClustersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/update_cluster_environment.html
 func (s *ClusterService) UpdateClusterEnvironment(i *UpdateClusterEnvironmentInput) (*UpdateClusterEnvironmentOutput, error) {
+	return s.UpdateClusterEnvironmentWithContext(context.Background(), i)
+}
+
+// UpdateClusterEnvironmentWithContext is the same as UpdateClusterEnvironment with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) UpdateClusterEnvironmentWithContext(ctx context.Context, i *UpdateClusterEnvironmentInput) (*UpdateClusterEnvironmentOutput, error) {
 	if i == nil {
 		i = &UpdateClusterEnvironmentInput{}
 	}
@@ -1299,8 +1697,12 @@ func (s *ClusterService) UpdateClusterEnvironment(i *UpdateClusterEnvironmentInp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1327,6 +1729,12 @@ type UpdateClusterEnvironmentOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/cluster/upgrade_clusters.html
 func (s *ClusterService) UpgradeClusters(i *UpgradeClustersInput) (*UpgradeClustersOutput, error) {
+	return s.UpgradeClustersWithContext(context.Background(), i)
+}
+
+// UpgradeClustersWithContext is the same as UpgradeClusters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ClusterService) UpgradeClustersWithContext(ctx context.Context, i *UpgradeClustersInput) (*UpgradeClustersOutput, error) {
 	if i == nil {
 		i = &UpgradeClustersInput{}
 	}
@@ -1342,8 +1750,12 @@ func (s *ClusterService) UpgradeClusters(i *UpgradeClustersInput) (*UpgradeClust
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type DNSAliasService struct {
 	Config     *config.Config
 	Properties *DNSAliasServiceProperties
+	Handlers   *request.Handlers
 }
 
 type DNSAliasServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) DNSAlias(zone string) (*DNSAliasService, error) {
 		Zone: &zone,
 	}
 
-	return &DNSAliasService{Config: s.Config, Properties: properties}, nil
+	return &DNSAliasService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/dns_alias/associate_dns_alias.html
 func (s *DNSAliasService) AssociateDNSAlias(i *AssociateDNSAliasInput) (*AssociateDNSAliasOutput, error) {
+	return s.AssociateDNSAliasWithContext(context.Background(), i)
+}
+
+// AssociateDNSAliasWithContext is the same as AssociateDNSAlias with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *DNSAliasService) AssociateDNSAliasWithContext(ctx context.Context, i *AssociateDNSAliasInput) (*AssociateDNSAliasOutput, error) {
 	if i == nil {
 		i = &AssociateDNSAliasInput{}
 	}
@@ -64,8 +72,12 @@ func (s *DNSAliasService) AssociateDNSAlias(i *AssociateDNSAliasInput) (*Associa
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +120,12 @@ type AssociateDNSAliasOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/dns_alias/describe_dns_aliases.html
 func (s *DNSAliasService) DescribeDNSAliases(i *DescribeDNSAliasesInput) (*DescribeDNSAliasesOutput, error) {
+	return s.DescribeDNSAliasesWithContext(context.Background(), i)
+}
+
+// DescribeDNSAliasesWithContext is the same as DescribeDNSAliases with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *DNSAliasService) DescribeDNSAliasesWithContext(ctx context.Context, i *DescribeDNSAliasesInput) (*DescribeDNSAliasesOutput, error) {
 	if i == nil {
 		i = &DescribeDNSAliasesInput{}
 	}
@@ -123,8 +141,12 @@ func (s *DNSAliasService) DescribeDNSAliases(i *DescribeDNSAliasesInput) (*Descr
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -153,8 +175,67 @@ type DescribeDNSAliasesOutput struct {
 	TotalCount  *int        `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeDNSAliasesPaginator pages through the results of DescribeDNSAliases.
+type DescribeDNSAliasesPaginator struct {
+	request.Pagination
+	service *DNSAliasService
+	input   DescribeDNSAliasesInput
+}
+
+// NewDescribeDNSAliasesPaginator create a paginator of DescribeDNSAliases starting at
+// the Offset of input.
+func NewDescribeDNSAliasesPaginator(s *DNSAliasService, i *DescribeDNSAliasesInput) *DescribeDNSAliasesPaginator {
+	if i == nil {
+		i = &DescribeDNSAliasesInput{}
+	}
+	return &DescribeDNSAliasesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeDNSAliases.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeDNSAliasesPaginator) NextPage(ctx context.Context) (*DescribeDNSAliasesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeDNSAliasesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.DNSAliasSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeDNSAliasesAll returns the DNSAliasSet of all the pages of DescribeDNSAliases
+// starting at the Offset of input.
+func (s *DNSAliasService) DescribeDNSAliasesAll(ctx context.Context, i *DescribeDNSAliasesInput) ([]*DNSAlias, error) {
+	p := NewDescribeDNSAliasesPaginator(s, i)
+	set := []*DNSAlias{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.DNSAliasSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/dns_alias/dissociate_dns_aliases.html
 func (s *DNSAliasService) DissociateDNSAliases(i *DissociateDNSAliasesInput) (*DissociateDNSAliasesOutput, error) {
+	return s.DissociateDNSAliasesWithContext(context.Background(), i)
+}
+
+// DissociateDNSAliasesWithContext is the same as DissociateDNSAliases with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *DNSAliasService) DissociateDNSAliasesWithContext(ctx context.Context, i *DissociateDNSAliasesInput) (*DissociateDNSAliasesOutput, error) {
 	if i == nil {
 		i = &DissociateDNSAliasesInput{}
 	}
@@ -170,8 +251,12 @@ func (s *DNSAliasService) DissociateDNSAliases(i *DissociateDNSAliasesInput) (*D
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +289,12 @@ type DissociateDNSAliasesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/dns_alias/get_dns_label.html
 func (s *DNSAliasService) GetDNSLabel(i *GetDNSLabelInput) (*GetDNSLabelOutput, error) {
+	return s.GetDNSLabelWithContext(context.Background(), i)
+}
+
+// GetDNSLabelWithContext is the same as GetDNSLabel with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *DNSAliasService) GetDNSLabelWithContext(ctx context.Context, i *GetDNSLabelInput) (*GetDNSLabelOutput, error) {
 	if i == nil {
 		i = &GetDNSLabelInput{}
 	}
@@ -219,8 +310,12 @@ func (s *DNSAliasService) GetDNSLabel(i *GetDNSLabelInput) (*GetDNSLabelOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
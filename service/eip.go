@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type EIPService struct {
 	Config     *config.Config
 	Properties *EIPServiceProperties
+	Handlers   *request.Handlers
 }
 
 type EIPServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) EIP(zone string) (*EIPService, error) {
 		Zone: &zone,
 	}
 
-	return &EIPService{Config: s.Config, Properties: properties}, nil
+	return &EIPService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/eip/allocate_eips.html
 func (s *EIPService) AllocateEIPs(i *AllocateEIPsInput) (*AllocateEIPsOutput, error) {
+	return s.AllocateEIPsWithContext(context.Background(), i)
+}
+
+// AllocateEIPsWithContext is the same as AllocateEIPs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *EIPService) AllocateEIPsWithContext(ctx context.Context, i *AllocateEIPsInput) (*AllocateEIPsOutput, error) {
 	if i == nil {
 		i = &AllocateEIPsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *EIPService) AllocateEIPs(i *AllocateEIPsInput) (*AllocateEIPsOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +156,12 @@ type AllocateEIPsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/eip/associate_eip.html
 func (s *EIPService) AssociateEIP(i *AssociateEIPInput) (*AssociateEIPOutput, error) {
+	return s.AssociateEIPWithContext(context.Background(), i)
+}
+
+// AssociateEIPWithContext is the same as AssociateEIP with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *EIPService) AssociateEIPWithContext(ctx context.Context, i *AssociateEIPInput) (*AssociateEIPOutput, error) {
 	if i == nil {
 		i = &AssociateEIPInput{}
 	}
@@ -159,8 +177,12 @@ func (s *EIPService) AssociateEIP(i *AssociateEIPInput) (*AssociateEIPOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +223,12 @@ type AssociateEIPOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/eip/dissociate_eips.html
 func (s *EIPService) ChangeEIPsBandwidth(i *ChangeEIPsBandwidthInput) (*ChangeEIPsBandwidthOutput, error) {
+	return s.ChangeEIPsBandwidthWithContext(context.Background(), i)
+}
+
+// ChangeEIPsBandwidthWithContext is the same as ChangeEIPsBandwidth with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *EIPService) ChangeEIPsBandwidthWithContext(ctx context.Context, i *ChangeEIPsBandwidthInput) (*ChangeEIPsBandwidthOutput, error) {
 	if i == nil {
 		i = &ChangeEIPsBandwidthInput{}
 	}
@@ -216,8 +244,12 @@ func (s *EIPService) ChangeEIPsBandwidth(i *ChangeEIPsBandwidthInput) (*ChangeEI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -258,6 +290,12 @@ type ChangeEIPsBandwidthOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/eip/change_eips_billing_mode.html
 func (s *EIPService) ChangeEIPsBillingMode(i *ChangeEIPsBillingModeInput) (*ChangeEIPsBillingModeOutput, error) {
+	return s.ChangeEIPsBillingModeWithContext(context.Background(), i)
+}
+
+// ChangeEIPsBillingModeWithContext is the same as ChangeEIPsBillingMode with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *EIPService) ChangeEIPsBillingModeWithContext(ctx context.Context, i *ChangeEIPsBillingModeInput) (*ChangeEIPsBillingModeOutput, error) {
 	if i == nil {
 		i = &ChangeEIPsBillingModeInput{}
 	}
@@ -273,8 +311,12 @@ func (s *EIPService) ChangeEIPsBillingMode(i *ChangeEIPsBillingModeInput) (*Chan
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -338,6 +380,12 @@ type ChangeEIPsBillingModeOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/eip/describe_eips.html
 func (s *EIPService) DescribeEIPs(i *DescribeEIPsInput) (*DescribeEIPsOutput, error) {
+	return s.DescribeEIPsWithContext(context.Background(), i)
+}
+
+// DescribeEIPsWithContext is the same as DescribeEIPs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *EIPService) DescribeEIPsWithContext(ctx context.Context, i *DescribeEIPsInput) (*DescribeEIPsOutput, error) {
 	if i == nil {
 		i = &DescribeEIPsInput{}
 	}
@@ -353,8 +401,12 @@ func (s *EIPService) DescribeEIPs(i *DescribeEIPsInput) (*DescribeEIPsOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -388,8 +440,99 @@ type DescribeEIPsOutput struct {
 	TotalCount *int    `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeEIPsPaginator pages through the results of DescribeEIPs.
+type DescribeEIPsPaginator struct {
+	request.Pagination
+	service *EIPService
+	input   DescribeEIPsInput
+}
+
+// NewDescribeEIPsPaginator create a paginator of DescribeEIPs starting at
+// the Offset of input.
+func NewDescribeEIPsPaginator(s *EIPService, i *DescribeEIPsInput) *DescribeEIPsPaginator {
+	if i == nil {
+		i = &DescribeEIPsInput{}
+	}
+	return &DescribeEIPsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeEIPs.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeEIPsPaginator) NextPage(ctx context.Context) (*DescribeEIPsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeEIPsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.EIPSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeEIPsAll returns the EIPSet of all the pages of DescribeEIPs
+// starting at the Offset of input.
+func (s *EIPService) DescribeEIPsAll(ctx context.Context, i *DescribeEIPsInput) ([]*EIP, error) {
+	p := NewDescribeEIPsPaginator(s, i)
+	set := []*EIP{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.EIPSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilEIPsStatus waits until all the given EIPs are in the
+// status without transition status, it fails if any of them is not found.
+func (s *EIPService) WaitUntilEIPsStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*EIP, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilEIPsStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeEIPsAll(ctx, &DescribeEIPsInput{
+				EIPs: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeEIPsOutput{EIPSet: set}, nil
+		},
+		StatusPath:           "EIPSet[].Status",
+		TransitionStatusPath: "EIPSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeEIPsOutput).EIPSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/eip/dissociate_eips.html
 func (s *EIPService) DissociateEIPs(i *DissociateEIPsInput) (*DissociateEIPsOutput, error) {
+	return s.DissociateEIPsWithContext(context.Background(), i)
+}
+
+// DissociateEIPsWithContext is the same as DissociateEIPs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *EIPService) DissociateEIPsWithContext(ctx context.Context, i *DissociateEIPsInput) (*DissociateEIPsOutput, error) {
 	if i == nil {
 		i = &DissociateEIPsInput{}
 	}
@@ -405,8 +548,12 @@ func (s *EIPService) DissociateEIPs(i *DissociateEIPsInput) (*DissociateEIPsOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -439,6 +586,12 @@ type DissociateEIPsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/eip/modify_eip_attributes.html
 func (s *EIPService) ModifyEIPAttributes(i *ModifyEIPAttributesInput) (*ModifyEIPAttributesOutput, error) {
+	return s.ModifyEIPAttributesWithContext(context.Background(), i)
+}
+
+// ModifyEIPAttributesWithContext is the same as ModifyEIPAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *EIPService) ModifyEIPAttributesWithContext(ctx context.Context, i *ModifyEIPAttributesInput) (*ModifyEIPAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyEIPAttributesInput{}
 	}
@@ -454,8 +607,12 @@ func (s *EIPService) ModifyEIPAttributes(i *ModifyEIPAttributesInput) (*ModifyEI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -490,6 +647,12 @@ type ModifyEIPAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/eip/release_eips.html
 func (s *EIPService) ReleaseEIPs(i *ReleaseEIPsInput) (*ReleaseEIPsOutput, error) {
+	return s.ReleaseEIPsWithContext(context.Background(), i)
+}
+
+// ReleaseEIPsWithContext is the same as ReleaseEIPs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *EIPService) ReleaseEIPsWithContext(ctx context.Context, i *ReleaseEIPsInput) (*ReleaseEIPsOutput, error) {
 	if i == nil {
 		i = &ReleaseEIPsInput{}
 	}
@@ -505,8 +668,12 @@ func (s *EIPService) ReleaseEIPs(i *ReleaseEIPsInput) (*ReleaseEIPsOutput, error
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
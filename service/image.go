@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type ImageService struct {
 	Config     *config.Config
 	Properties *ImageServiceProperties
+	Handlers   *request.Handlers
 }
 
 type ImageServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Image(zone string) (*ImageService, error) {
 		Zone: &zone,
 	}
 
-	return &ImageService{Config: s.Config, Properties: properties}, nil
+	return &ImageService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/image/capture_instance.html
 func (s *ImageService) CaptureInstance(i *CaptureInstanceInput) (*CaptureInstanceOutput, error) {
+	return s.CaptureInstanceWithContext(context.Background(), i)
+}
+
+// CaptureInstanceWithContext is the same as CaptureInstance with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ImageService) CaptureInstanceWithContext(ctx context.Context, i *CaptureInstanceInput) (*CaptureInstanceOutput, error) {
 	if i == nil {
 		i = &CaptureInstanceInput{}
 	}
@@ -64,8 +72,12 @@ func (s *ImageService) CaptureInstance(i *CaptureInstanceInput) (*CaptureInstanc
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +112,12 @@ type CaptureInstanceOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/image/delete_images.html
 func (s *ImageService) DeleteImages(i *DeleteImagesInput) (*DeleteImagesOutput, error) {
+	return s.DeleteImagesWithContext(context.Background(), i)
+}
+
+// DeleteImagesWithContext is the same as DeleteImages with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ImageService) DeleteImagesWithContext(ctx context.Context, i *DeleteImagesInput) (*DeleteImagesOutput, error) {
 	if i == nil {
 		i = &DeleteImagesInput{}
 	}
@@ -115,8 +133,12 @@ func (s *ImageService) DeleteImages(i *DeleteImagesInput) (*DeleteImagesOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +171,12 @@ type DeleteImagesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/image/describe-image-users.html
 func (s *ImageService) DescribeImageUsers(i *DescribeImageUsersInput) (*DescribeImageUsersOutput, error) {
+	return s.DescribeImageUsersWithContext(context.Background(), i)
+}
+
+// DescribeImageUsersWithContext is the same as DescribeImageUsers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ImageService) DescribeImageUsersWithContext(ctx context.Context, i *DescribeImageUsersInput) (*DescribeImageUsersOutput, error) {
 	if i == nil {
 		i = &DescribeImageUsersInput{}
 	}
@@ -164,8 +192,12 @@ func (s *ImageService) DescribeImageUsers(i *DescribeImageUsersInput) (*Describe
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -199,8 +231,67 @@ type DescribeImageUsersOutput struct {
 	TotalCount   *int         `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeImageUsersPaginator pages through the results of DescribeImageUsers.
+type DescribeImageUsersPaginator struct {
+	request.Pagination
+	service *ImageService
+	input   DescribeImageUsersInput
+}
+
+// NewDescribeImageUsersPaginator create a paginator of DescribeImageUsers starting at
+// the Offset of input.
+func NewDescribeImageUsersPaginator(s *ImageService, i *DescribeImageUsersInput) *DescribeImageUsersPaginator {
+	if i == nil {
+		i = &DescribeImageUsersInput{}
+	}
+	return &DescribeImageUsersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeImageUsers.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeImageUsersPaginator) NextPage(ctx context.Context) (*DescribeImageUsersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeImageUsersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.ImageUserSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeImageUsersAll returns the ImageUserSet of all the pages of DescribeImageUsers
+// starting at the Offset of input.
+func (s *ImageService) DescribeImageUsersAll(ctx context.Context, i *DescribeImageUsersInput) ([]*ImageUser, error) {
+	p := NewDescribeImageUsersPaginator(s, i)
+	set := []*ImageUser{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.ImageUserSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/image/describe_images.html
 func (s *ImageService) DescribeImages(i *DescribeImagesInput) (*DescribeImagesOutput, error) {
+	return s.DescribeImagesWithContext(context.Background(), i)
+}
+
+// DescribeImagesWithContext is the same as DescribeImages with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ImageService) DescribeImagesWithContext(ctx context.Context, i *DescribeImagesInput) (*DescribeImagesOutput, error) {
 	if i == nil {
 		i = &DescribeImagesInput{}
 	}
@@ -216,8 +307,12 @@ func (s *ImageService) DescribeImages(i *DescribeImagesInput) (*DescribeImagesOu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -338,8 +433,99 @@ type DescribeImagesOutput struct {
 	TotalCount *int     `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeImagesPaginator pages through the results of DescribeImages.
+type DescribeImagesPaginator struct {
+	request.Pagination
+	service *ImageService
+	input   DescribeImagesInput
+}
+
+// NewDescribeImagesPaginator create a paginator of DescribeImages starting at
+// the Offset of input.
+func NewDescribeImagesPaginator(s *ImageService, i *DescribeImagesInput) *DescribeImagesPaginator {
+	if i == nil {
+		i = &DescribeImagesInput{}
+	}
+	return &DescribeImagesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeImages.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeImagesPaginator) NextPage(ctx context.Context) (*DescribeImagesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeImagesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.ImageSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeImagesAll returns the ImageSet of all the pages of DescribeImages
+// starting at the Offset of input.
+func (s *ImageService) DescribeImagesAll(ctx context.Context, i *DescribeImagesInput) ([]*Image, error) {
+	p := NewDescribeImagesPaginator(s, i)
+	set := []*Image{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.ImageSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilImagesStatus waits until all the given Images are in the
+// status without transition status, it fails if any of them is not found.
+func (s *ImageService) WaitUntilImagesStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*Image, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilImagesStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeImagesAll(ctx, &DescribeImagesInput{
+				Images: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeImagesOutput{ImageSet: set}, nil
+		},
+		StatusPath:           "ImageSet[].Status",
+		TransitionStatusPath: "ImageSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeImagesOutput).ImageSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/image/grant-image-to-users.html
 func (s *ImageService) GrantImageToUsers(i *GrantImageToUsersInput) (*GrantImageToUsersOutput, error) {
+	return s.GrantImageToUsersWithContext(context.Background(), i)
+}
+
+// GrantImageToUsersWithContext is the same as GrantImageToUsers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ImageService) GrantImageToUsersWithContext(ctx context.Context, i *GrantImageToUsersInput) (*GrantImageToUsersOutput, error) {
 	if i == nil {
 		i = &GrantImageToUsersInput{}
 	}
@@ -355,8 +541,12 @@ func (s *ImageService) GrantImageToUsers(i *GrantImageToUsersInput) (*GrantImage
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -396,6 +586,12 @@ type GrantImageToUsersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/image/modify_image_attributes.html
 func (s *ImageService) ModifyImageAttributes(i *ModifyImageAttributesInput) (*ModifyImageAttributesOutput, error) {
+	return s.ModifyImageAttributesWithContext(context.Background(), i)
+}
+
+// ModifyImageAttributesWithContext is the same as ModifyImageAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ImageService) ModifyImageAttributesWithContext(ctx context.Context, i *ModifyImageAttributesInput) (*ModifyImageAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyImageAttributesInput{}
 	}
@@ -411,8 +607,12 @@ func (s *ImageService) ModifyImageAttributes(i *ModifyImageAttributesInput) (*Mo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -447,6 +647,12 @@ type ModifyImageAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/image/revoke-image-from-users.html
 func (s *ImageService) RevokeImageFromUsers(i *RevokeImageFromUsersInput) (*RevokeImageFromUsersOutput, error) {
+	return s.RevokeImageFromUsersWithContext(context.Background(), i)
+}
+
+// RevokeImageFromUsersWithContext is the same as RevokeImageFromUsers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ImageService) RevokeImageFromUsersWithContext(ctx context.Context, i *RevokeImageFromUsersInput) (*RevokeImageFromUsersOutput, error) {
 	if i == nil {
 		i = &RevokeImageFromUsersInput{}
 	}
@@ -462,8 +668,12 @@ func (s *ImageService) RevokeImageFromUsers(i *RevokeImageFromUsersInput) (*Revo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
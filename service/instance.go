@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type InstanceService struct {
 	Config     *config.Config
 	Properties *InstanceServiceProperties
+	Handlers   *request.Handlers
 }
 
 type InstanceServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Instance(zone string) (*InstanceService, error) {
 		Zone: &zone,
 	}
 
-	return &InstanceService{Config: s.Config, Properties: properties}, nil
+	return &InstanceService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/cease_instances.html
 func (s *InstanceService) CeaseInstances(i *CeaseInstancesInput) (*CeaseInstancesOutput, error) {
+	return s.CeaseInstancesWithContext(context.Background(), i)
+}
+
+// CeaseInstancesWithContext is the same as CeaseInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) CeaseInstancesWithContext(ctx context.Context, i *CeaseInstancesInput) (*CeaseInstancesOutput, error) {
 	if i == nil {
 		i = &CeaseInstancesInput{}
 	}
@@ -64,8 +72,12 @@ func (s *InstanceService) CeaseInstances(i *CeaseInstancesInput) (*CeaseInstance
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +110,12 @@ type CeaseInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/describe_instance_types.html
 func (s *InstanceService) DescribeInstanceTypes(i *DescribeInstanceTypesInput) (*DescribeInstanceTypesOutput, error) {
+	return s.DescribeInstanceTypesWithContext(context.Background(), i)
+}
+
+// DescribeInstanceTypesWithContext is the same as DescribeInstanceTypes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) DescribeInstanceTypesWithContext(ctx context.Context, i *DescribeInstanceTypesInput) (*DescribeInstanceTypesOutput, error) {
 	if i == nil {
 		i = &DescribeInstanceTypesInput{}
 	}
@@ -113,8 +131,12 @@ func (s *InstanceService) DescribeInstanceTypes(i *DescribeInstanceTypesInput) (
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +165,12 @@ type DescribeInstanceTypesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/describe_instances.html
 func (s *InstanceService) DescribeInstances(i *DescribeInstancesInput) (*DescribeInstancesOutput, error) {
+	return s.DescribeInstancesWithContext(context.Background(), i)
+}
+
+// DescribeInstancesWithContext is the same as DescribeInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) DescribeInstancesWithContext(ctx context.Context, i *DescribeInstancesInput) (*DescribeInstancesOutput, error) {
 	if i == nil {
 		i = &DescribeInstancesInput{}
 	}
@@ -158,8 +186,12 @@ func (s *InstanceService) DescribeInstances(i *DescribeInstancesInput) (*Describ
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -310,8 +342,99 @@ type DescribeInstancesOutput struct {
 	TotalCount  *int        `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeInstancesPaginator pages through the results of DescribeInstances.
+type DescribeInstancesPaginator struct {
+	request.Pagination
+	service *InstanceService
+	input   DescribeInstancesInput
+}
+
+// NewDescribeInstancesPaginator create a paginator of DescribeInstances starting at
+// the Offset of input.
+func NewDescribeInstancesPaginator(s *InstanceService, i *DescribeInstancesInput) *DescribeInstancesPaginator {
+	if i == nil {
+		i = &DescribeInstancesInput{}
+	}
+	return &DescribeInstancesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeInstances.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeInstancesPaginator) NextPage(ctx context.Context) (*DescribeInstancesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeInstancesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.InstanceSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeInstancesAll returns the InstanceSet of all the pages of DescribeInstances
+// starting at the Offset of input.
+func (s *InstanceService) DescribeInstancesAll(ctx context.Context, i *DescribeInstancesInput) ([]*Instance, error) {
+	p := NewDescribeInstancesPaginator(s, i)
+	set := []*Instance{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.InstanceSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilInstancesStatus waits until all the given Instances are in the
+// status without transition status, it fails if any of them is not found.
+func (s *InstanceService) WaitUntilInstancesStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*Instance, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilInstancesStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeInstancesAll(ctx, &DescribeInstancesInput{
+				Instances: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeInstancesOutput{InstanceSet: set}, nil
+		},
+		StatusPath:           "InstanceSet[].Status",
+		TransitionStatusPath: "InstanceSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeInstancesOutput).InstanceSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/instance/modify_instance_attributes.html
 func (s *InstanceService) ModifyInstanceAttributes(i *ModifyInstanceAttributesInput) (*ModifyInstanceAttributesOutput, error) {
+	return s.ModifyInstanceAttributesWithContext(context.Background(), i)
+}
+
+// ModifyInstanceAttributesWithContext is the same as ModifyInstanceAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) ModifyInstanceAttributesWithContext(ctx context.Context, i *ModifyInstanceAttributesInput) (*ModifyInstanceAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyInstanceAttributesInput{}
 	}
@@ -327,8 +450,12 @@ func (s *InstanceService) ModifyInstanceAttributes(i *ModifyInstanceAttributesIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +490,12 @@ type ModifyInstanceAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/reset_instances.html
 func (s *InstanceService) ResetInstances(i *ResetInstancesInput) (*ResetInstancesOutput, error) {
+	return s.ResetInstancesWithContext(context.Background(), i)
+}
+
+// ResetInstancesWithContext is the same as ResetInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) ResetInstancesWithContext(ctx context.Context, i *ResetInstancesInput) (*ResetInstancesOutput, error) {
 	if i == nil {
 		i = &ResetInstancesInput{}
 	}
@@ -378,8 +511,12 @@ func (s *InstanceService) ResetInstances(i *ResetInstancesInput) (*ResetInstance
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -477,6 +614,12 @@ type ResetInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/resize_instances.html
 func (s *InstanceService) ResizeInstances(i *ResizeInstancesInput) (*ResizeInstancesOutput, error) {
+	return s.ResizeInstancesWithContext(context.Background(), i)
+}
+
+// ResizeInstancesWithContext is the same as ResizeInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) ResizeInstancesWithContext(ctx context.Context, i *ResizeInstancesInput) (*ResizeInstancesOutput, error) {
 	if i == nil {
 		i = &ResizeInstancesInput{}
 	}
@@ -492,8 +635,12 @@ func (s *InstanceService) ResizeInstances(i *ResizeInstancesInput) (*ResizeInsta
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -586,6 +733,12 @@ type ResizeInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/restart_instances.html
 func (s *InstanceService) RestartInstances(i *RestartInstancesInput) (*RestartInstancesOutput, error) {
+	return s.RestartInstancesWithContext(context.Background(), i)
+}
+
+// RestartInstancesWithContext is the same as RestartInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) RestartInstancesWithContext(ctx context.Context, i *RestartInstancesInput) (*RestartInstancesOutput, error) {
 	if i == nil {
 		i = &RestartInstancesInput{}
 	}
@@ -601,8 +754,12 @@ func (s *InstanceService) RestartInstances(i *RestartInstancesInput) (*RestartIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -635,6 +792,12 @@ type RestartInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/run_instances.html
 func (s *InstanceService) RunInstances(i *RunInstancesInput) (*RunInstancesOutput, error) {
+	return s.RunInstancesWithContext(context.Background(), i)
+}
+
+// RunInstancesWithContext is the same as RunInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) RunInstancesWithContext(ctx context.Context, i *RunInstancesInput) (*RunInstancesOutput, error) {
 	if i == nil {
 		i = &RunInstancesInput{}
 	}
@@ -650,8 +813,12 @@ func (s *InstanceService) RunInstances(i *RunInstancesInput) (*RunInstancesOutpu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1012,6 +1179,12 @@ type RunInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/start_instances.html
 func (s *InstanceService) StartInstances(i *StartInstancesInput) (*StartInstancesOutput, error) {
+	return s.StartInstancesWithContext(context.Background(), i)
+}
+
+// StartInstancesWithContext is the same as StartInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) StartInstancesWithContext(ctx context.Context, i *StartInstancesInput) (*StartInstancesOutput, error) {
 	if i == nil {
 		i = &StartInstancesInput{}
 	}
@@ -1027,8 +1200,12 @@ func (s *InstanceService) StartInstances(i *StartInstancesInput) (*StartInstance
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1062,6 +1239,12 @@ type StartInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/stop_instances.html
 func (s *InstanceService) StopInstances(i *StopInstancesInput) (*StopInstancesOutput, error) {
+	return s.StopInstancesWithContext(context.Background(), i)
+}
+
+// StopInstancesWithContext is the same as StopInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) StopInstancesWithContext(ctx context.Context, i *StopInstancesInput) (*StopInstancesOutput, error) {
 	if i == nil {
 		i = &StopInstancesInput{}
 	}
@@ -1077,8 +1260,12 @@ func (s *InstanceService) StopInstances(i *StopInstancesInput) (*StopInstancesOu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1134,6 +1321,12 @@ type StopInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/terminate_instances.html
 func (s *InstanceService) TerminateInstances(i *TerminateInstancesInput) (*TerminateInstancesOutput, error) {
+	return s.TerminateInstancesWithContext(context.Background(), i)
+}
+
+// TerminateInstancesWithContext is the same as TerminateInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) TerminateInstancesWithContext(ctx context.Context, i *TerminateInstancesInput) (*TerminateInstancesOutput, error) {
 	if i == nil {
 		i = &TerminateInstancesInput{}
 	}
@@ -1149,8 +1342,12 @@ func (s *InstanceService) TerminateInstances(i *TerminateInstancesInput) (*Termi
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1183,6 +1380,12 @@ type TerminateInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/instance/clone_instances.html
 func (s *InstanceService) CloneInstances(i *CloneInstancesInput) (*CloneInstancesOutput, error) {
+	return s.CloneInstancesWithContext(context.Background(), i)
+}
+
+// CloneInstancesWithContext is the same as CloneInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) CloneInstancesWithContext(ctx context.Context, i *CloneInstancesInput) (*CloneInstancesOutput, error) {
 	if i == nil {
 		i = &CloneInstancesInput{}
 	}
@@ -1198,8 +1401,12 @@ func (s *InstanceService) CloneInstances(i *CloneInstancesInput) (*CloneInstance
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1234,6 +1441,12 @@ type InstanceDetails struct {
 // CreateBrokers: CreateBrokers
 
 func (s *InstanceService) CreateBrokers(i *CreateBrokersInput) (*CreateBrokersOutput, error) {
+	return s.CreateBrokersWithContext(context.Background(), i)
+}
+
+// CreateBrokersWithContext is the same as CreateBrokers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) CreateBrokersWithContext(ctx context.Context, i *CreateBrokersInput) (*CreateBrokersOutput, error) {
 	if i == nil {
 		i = &CreateBrokersInput{}
 	}
@@ -1249,8 +1462,12 @@ func (s *InstanceService) CreateBrokers(i *CreateBrokersInput) (*CreateBrokersOu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1283,6 +1500,12 @@ type Broker struct {
 // DeleteBrokers: DeleteBrokers
 
 func (s *InstanceService) DeleteBrokers(i *DeleteBrokersInput) (*DeleteBrokersOutput, error) {
+	return s.DeleteBrokersWithContext(context.Background(), i)
+}
+
+// DeleteBrokersWithContext is the same as DeleteBrokers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) DeleteBrokersWithContext(ctx context.Context, i *DeleteBrokersInput) (*DeleteBrokersOutput, error) {
 	if i == nil {
 		i = &DeleteBrokersInput{}
 	}
@@ -1298,8 +1521,12 @@ func (s *InstanceService) DeleteBrokers(i *DeleteBrokersInput) (*DeleteBrokersOu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1326,6 +1553,12 @@ type DeleteBrokersOutput struct {
 
 // ApplyInstanceGroup: ApplyInstanceGroup
 func (s *InstanceService) ApplyInstanceGroup(i *ApplyInstanceGroupInput) (*ApplyInstanceGroupOutput, error) {
+	return s.ApplyInstanceGroupWithContext(context.Background(), i)
+}
+
+// ApplyInstanceGroupWithContext is the same as ApplyInstanceGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) ApplyInstanceGroupWithContext(ctx context.Context, i *ApplyInstanceGroupInput) (*ApplyInstanceGroupOutput, error) {
 	if i == nil {
 		i = &ApplyInstanceGroupInput{}
 	}
@@ -1341,8 +1574,12 @@ func (s *InstanceService) ApplyInstanceGroup(i *ApplyInstanceGroupInput) (*Apply
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1370,6 +1607,12 @@ type ApplyInstanceGroupOutput struct {
 // CreateInstanceGroups: CreateInstanceGroups
 
 func (s *InstanceService) CreateInstanceGroups(i *CreateInstanceGroupsInput) (*CreateInstanceGroupsOutput, error) {
+	return s.CreateInstanceGroupsWithContext(context.Background(), i)
+}
+
+// CreateInstanceGroupsWithContext is the same as CreateInstanceGroups with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) CreateInstanceGroupsWithContext(ctx context.Context, i *CreateInstanceGroupsInput) (*CreateInstanceGroupsOutput, error) {
 	if i == nil {
 		i = &CreateInstanceGroupsInput{}
 	}
@@ -1385,8 +1628,12 @@ func (s *InstanceService) CreateInstanceGroups(i *CreateInstanceGroupsInput) (*C
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1424,6 +1671,12 @@ type CreateInstanceGroupsOutput struct {
 // DeleteInstanceGroups: DeleteInstanceGroups
 
 func (s *InstanceService) DeleteInstanceGroups(i *DeleteInstanceGroupsInput) (*DeleteInstanceGroupsOutput, error) {
+	return s.DeleteInstanceGroupsWithContext(context.Background(), i)
+}
+
+// DeleteInstanceGroupsWithContext is the same as DeleteInstanceGroups with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) DeleteInstanceGroupsWithContext(ctx context.Context, i *DeleteInstanceGroupsInput) (*DeleteInstanceGroupsOutput, error) {
 	if i == nil {
 		i = &DeleteInstanceGroupsInput{}
 	}
@@ -1439,8 +1692,12 @@ func (s *InstanceService) DeleteInstanceGroups(i *DeleteInstanceGroupsInput) (*D
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1471,6 +1728,12 @@ type DeleteInstanceGroupsOutput struct {
 // DescribeInstanceGroups: DescribeInstanceGroups
 
 func (s *InstanceService) DescribeInstanceGroups(i *DescribeInstanceGroupsInput) (*DescribeInstanceGroupsOutput, error) {
+	return s.DescribeInstanceGroupsWithContext(context.Background(), i)
+}
+
+// DescribeInstanceGroupsWithContext is the same as DescribeInstanceGroups with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) DescribeInstanceGroupsWithContext(ctx context.Context, i *DescribeInstanceGroupsInput) (*DescribeInstanceGroupsOutput, error) {
 	if i == nil {
 		i = &DescribeInstanceGroupsInput{}
 	}
@@ -1486,8 +1749,12 @@ func (s *InstanceService) DescribeInstanceGroups(i *DescribeInstanceGroupsInput)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1536,6 +1803,60 @@ type DescribeInstanceGroupsOutput struct {
 	InstanceGroups []*InstanceGroup `json:"instance_group_set"  name:"instance_group_set"  location:"elements"`
 	RetCode        *int             `json:"ret_code" name:"ret_code" location:"elements"`
 }
+
+// DescribeInstanceGroupsPaginator pages through the results of DescribeInstanceGroups.
+type DescribeInstanceGroupsPaginator struct {
+	request.Pagination
+	service *InstanceService
+	input   DescribeInstanceGroupsInput
+}
+
+// NewDescribeInstanceGroupsPaginator create a paginator of DescribeInstanceGroups starting at
+// the Offset of input.
+func NewDescribeInstanceGroupsPaginator(s *InstanceService, i *DescribeInstanceGroupsInput) *DescribeInstanceGroupsPaginator {
+	if i == nil {
+		i = &DescribeInstanceGroupsInput{}
+	}
+	return &DescribeInstanceGroupsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeInstanceGroups.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeInstanceGroupsPaginator) NextPage(ctx context.Context) (*DescribeInstanceGroupsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeInstanceGroupsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.InstanceGroups), nil)
+	return o, nil
+}
+
+// DescribeInstanceGroupsAll returns the InstanceGroups of all the pages of DescribeInstanceGroups
+// starting at the Offset of input.
+func (s *InstanceService) DescribeInstanceGroupsAll(ctx context.Context, i *DescribeInstanceGroupsInput) ([]*InstanceGroup, error) {
+	p := NewDescribeInstanceGroupsPaginator(s, i)
+	set := []*InstanceGroup{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.InstanceGroups...)
+	}
+	return set, nil
+}
+
 type InstanceGroup struct {
 	InstanceGroupName *string   `json:"instance_group_name"`
 	Description       *string   `json:"description"`
@@ -1552,6 +1873,12 @@ type InstanceGroup struct {
 // ModifyInstanceGroupAttributes: ModifyInstanceGroupAttributes
 
 func (s *InstanceService) ModifyInstanceGroupAttributes(i *ModifyInstanceGroupAttributesInput) (*ModifyInstanceGroupAttributesOutput, error) {
+	return s.ModifyInstanceGroupAttributesWithContext(context.Background(), i)
+}
+
+// ModifyInstanceGroupAttributesWithContext is the same as ModifyInstanceGroupAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) ModifyInstanceGroupAttributesWithContext(ctx context.Context, i *ModifyInstanceGroupAttributesInput) (*ModifyInstanceGroupAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyInstanceGroupAttributesInput{}
 	}
@@ -1567,8 +1894,12 @@ func (s *InstanceService) ModifyInstanceGroupAttributes(i *ModifyInstanceGroupAt
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1603,6 +1934,12 @@ type ModifyInstanceGroupAttributesOutput struct {
 // JoinInstanceGroup: JoinInstanceGroup
 
 func (s *InstanceService) JoinInstanceGroup(i *JoinInstanceGroupInput) (*JoinInstanceGroupOutput, error) {
+	return s.JoinInstanceGroupWithContext(context.Background(), i)
+}
+
+// JoinInstanceGroupWithContext is the same as JoinInstanceGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) JoinInstanceGroupWithContext(ctx context.Context, i *JoinInstanceGroupInput) (*JoinInstanceGroupOutput, error) {
 	if i == nil {
 		i = &JoinInstanceGroupInput{}
 	}
@@ -1618,8 +1955,12 @@ func (s *InstanceService) JoinInstanceGroup(i *JoinInstanceGroupInput) (*JoinIns
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1651,6 +1992,12 @@ type JoinInstanceGroupOutput struct {
 // LeaveInstanceGroup: LeaveInstanceGroup
 
 func (s *InstanceService) LeaveInstanceGroup(i *LeaveInstanceGroupInput) (*LeaveInstanceGroupOutput, error) {
+	return s.LeaveInstanceGroupWithContext(context.Background(), i)
+}
+
+// LeaveInstanceGroupWithContext is the same as LeaveInstanceGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *InstanceService) LeaveInstanceGroupWithContext(ctx context.Context, i *LeaveInstanceGroupInput) (*LeaveInstanceGroupOutput, error) {
 	if i == nil {
 		i = &LeaveInstanceGroupInput{}
 	}
@@ -1666,8 +2013,12 @@ func (s *InstanceService) LeaveInstanceGroup(i *LeaveInstanceGroupInput) (*Leave
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type JobService struct {
 	Config     *config.Config
 	Properties *JobServiceProperties
+	Handlers   *request.Handlers
 }
 
 type JobServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Job(zone string) (*JobService, error) {
 		Zone: &zone,
 	}
 
-	return &JobService{Config: s.Config, Properties: properties}, nil
+	return &JobService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/job/describe_jobs.html
 func (s *JobService) DescribeJobs(i *DescribeJobsInput) (*DescribeJobsOutput, error) {
+	return s.DescribeJobsWithContext(context.Background(), i)
+}
+
+// DescribeJobsWithContext is the same as DescribeJobs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *JobService) DescribeJobsWithContext(ctx context.Context, i *DescribeJobsInput) (*DescribeJobsOutput, error) {
 	if i == nil {
 		i = &DescribeJobsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *JobService) DescribeJobs(i *DescribeJobsInput) (*DescribeJobsOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -115,3 +127,56 @@ type DescribeJobsOutput struct {
 	RetCode    *int    `json:"ret_code" name:"ret_code" location:"elements"`
 	TotalCount *int    `json:"total_count" name:"total_count" location:"elements"`
 }
+
+// DescribeJobsPaginator pages through the results of DescribeJobs.
+type DescribeJobsPaginator struct {
+	request.Pagination
+	service *JobService
+	input   DescribeJobsInput
+}
+
+// NewDescribeJobsPaginator create a paginator of DescribeJobs starting at
+// the Offset of input.
+func NewDescribeJobsPaginator(s *JobService, i *DescribeJobsInput) *DescribeJobsPaginator {
+	if i == nil {
+		i = &DescribeJobsInput{}
+	}
+	return &DescribeJobsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeJobs.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeJobsPaginator) NextPage(ctx context.Context) (*DescribeJobsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeJobsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.JobSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeJobsAll returns the JobSet of all the pages of DescribeJobs
+// starting at the Offset of input.
+func (s *JobService) DescribeJobsAll(ctx context.Context, i *DescribeJobsInput) ([]*Job, error) {
+	p := NewDescribeJobsPaginator(s, i)
+	set := []*Job{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.JobSet...)
+	}
+	return set, nil
+}
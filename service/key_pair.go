@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type KeyPairService struct {
 	Config     *config.Config
 	Properties *KeyPairServiceProperties
+	Handlers   *request.Handlers
 }
 
 type KeyPairServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) KeyPair(zone string) (*KeyPairService, error) {
 		Zone: &zone,
 	}
 
-	return &KeyPairService{Config: s.Config, Properties: properties}, nil
+	return &KeyPairService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/keypair/attach_key_pairs.html
 func (s *KeyPairService) AttachKeyPairs(i *AttachKeyPairsInput) (*AttachKeyPairsOutput, error) {
+	return s.AttachKeyPairsWithContext(context.Background(), i)
+}
+
+// AttachKeyPairsWithContext is the same as AttachKeyPairs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *KeyPairService) AttachKeyPairsWithContext(ctx context.Context, i *AttachKeyPairsInput) (*AttachKeyPairsOutput, error) {
 	if i == nil {
 		i = &AttachKeyPairsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *KeyPairService) AttachKeyPairs(i *AttachKeyPairsInput) (*AttachKeyPairs
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +118,12 @@ type AttachKeyPairsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/keypair/create_key_pairs.html
 func (s *KeyPairService) CreateKeyPair(i *CreateKeyPairInput) (*CreateKeyPairOutput, error) {
+	return s.CreateKeyPairWithContext(context.Background(), i)
+}
+
+// CreateKeyPairWithContext is the same as CreateKeyPair with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *KeyPairService) CreateKeyPairWithContext(ctx context.Context, i *CreateKeyPairInput) (*CreateKeyPairOutput, error) {
 	if i == nil {
 		i = &CreateKeyPairInput{}
 	}
@@ -121,8 +139,12 @@ func (s *KeyPairService) CreateKeyPair(i *CreateKeyPairInput) (*CreateKeyPairOut
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +217,12 @@ type CreateKeyPairOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/keypair/delete_key_pairs.html
 func (s *KeyPairService) DeleteKeyPairs(i *DeleteKeyPairsInput) (*DeleteKeyPairsOutput, error) {
+	return s.DeleteKeyPairsWithContext(context.Background(), i)
+}
+
+// DeleteKeyPairsWithContext is the same as DeleteKeyPairs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *KeyPairService) DeleteKeyPairsWithContext(ctx context.Context, i *DeleteKeyPairsInput) (*DeleteKeyPairsOutput, error) {
 	if i == nil {
 		i = &DeleteKeyPairsInput{}
 	}
@@ -210,8 +238,12 @@ func (s *KeyPairService) DeleteKeyPairs(i *DeleteKeyPairsInput) (*DeleteKeyPairs
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -244,6 +276,12 @@ type DeleteKeyPairsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/keypair/describe_key_pairs.html
 func (s *KeyPairService) DescribeKeyPairs(i *DescribeKeyPairsInput) (*DescribeKeyPairsOutput, error) {
+	return s.DescribeKeyPairsWithContext(context.Background(), i)
+}
+
+// DescribeKeyPairsWithContext is the same as DescribeKeyPairs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *KeyPairService) DescribeKeyPairsWithContext(ctx context.Context, i *DescribeKeyPairsInput) (*DescribeKeyPairsOutput, error) {
 	if i == nil {
 		i = &DescribeKeyPairsInput{}
 	}
@@ -259,8 +297,12 @@ func (s *KeyPairService) DescribeKeyPairs(i *DescribeKeyPairsInput) (*DescribeKe
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -316,8 +358,67 @@ type DescribeKeyPairsOutput struct {
 	TotalCount *int       `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeKeyPairsPaginator pages through the results of DescribeKeyPairs.
+type DescribeKeyPairsPaginator struct {
+	request.Pagination
+	service *KeyPairService
+	input   DescribeKeyPairsInput
+}
+
+// NewDescribeKeyPairsPaginator create a paginator of DescribeKeyPairs starting at
+// the Offset of input.
+func NewDescribeKeyPairsPaginator(s *KeyPairService, i *DescribeKeyPairsInput) *DescribeKeyPairsPaginator {
+	if i == nil {
+		i = &DescribeKeyPairsInput{}
+	}
+	return &DescribeKeyPairsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeKeyPairs.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeKeyPairsPaginator) NextPage(ctx context.Context) (*DescribeKeyPairsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeKeyPairsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.KeyPairSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeKeyPairsAll returns the KeyPairSet of all the pages of DescribeKeyPairs
+// starting at the Offset of input.
+func (s *KeyPairService) DescribeKeyPairsAll(ctx context.Context, i *DescribeKeyPairsInput) ([]*KeyPair, error) {
+	p := NewDescribeKeyPairsPaginator(s, i)
+	set := []*KeyPair{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.KeyPairSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/keypair/detach_key_pairs.html
 func (s *KeyPairService) DetachKeyPairs(i *DetachKeyPairsInput) (*DetachKeyPairsOutput, error) {
+	return s.DetachKeyPairsWithContext(context.Background(), i)
+}
+
+// DetachKeyPairsWithContext is the same as DetachKeyPairs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *KeyPairService) DetachKeyPairsWithContext(ctx context.Context, i *DetachKeyPairsInput) (*DetachKeyPairsOutput, error) {
 	if i == nil {
 		i = &DetachKeyPairsInput{}
 	}
@@ -333,8 +434,12 @@ func (s *KeyPairService) DetachKeyPairs(i *DetachKeyPairsInput) (*DetachKeyPairs
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -375,6 +480,12 @@ type DetachKeyPairsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/keypair/modify_key_pair_attributes.html
 func (s *KeyPairService) ModifyKeyPairAttributes(i *ModifyKeyPairAttributesInput) (*ModifyKeyPairAttributesOutput, error) {
+	return s.ModifyKeyPairAttributesWithContext(context.Background(), i)
+}
+
+// ModifyKeyPairAttributesWithContext is the same as ModifyKeyPairAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *KeyPairService) ModifyKeyPairAttributesWithContext(ctx context.Context, i *ModifyKeyPairAttributesInput) (*ModifyKeyPairAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyKeyPairAttributesInput{}
 	}
@@ -390,8 +501,12 @@ func (s *KeyPairService) ModifyKeyPairAttributes(i *ModifyKeyPairAttributesInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
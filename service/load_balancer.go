@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type LoadBalancerService struct {
 	Config     *config.Config
 	Properties *LoadBalancerServiceProperties
+	Handlers   *request.Handlers
 }
 
 type LoadBalancerServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) LoadBalancer(zone string) (*LoadBalancerService, erro
 		Zone: &zone,
 	}
 
-	return &LoadBalancerService{Config: s.Config, Properties: properties}, nil
+	return &LoadBalancerService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/add_loadbalancer_backends.html
 func (s *LoadBalancerService) AddLoadBalancerBackends(i *AddLoadBalancerBackendsInput) (*AddLoadBalancerBackendsOutput, error) {
+	return s.AddLoadBalancerBackendsWithContext(context.Background(), i)
+}
+
+// AddLoadBalancerBackendsWithContext is the same as AddLoadBalancerBackends with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) AddLoadBalancerBackendsWithContext(ctx context.Context, i *AddLoadBalancerBackendsInput) (*AddLoadBalancerBackendsOutput, error) {
 	if i == nil {
 		i = &AddLoadBalancerBackendsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *LoadBalancerService) AddLoadBalancerBackends(i *AddLoadBalancerBackends
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +126,12 @@ type AddLoadBalancerBackendsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/add_loadbalancer_listeners.html
 func (s *LoadBalancerService) AddLoadBalancerListeners(i *AddLoadBalancerListenersInput) (*AddLoadBalancerListenersOutput, error) {
+	return s.AddLoadBalancerListenersWithContext(context.Background(), i)
+}
+
+// AddLoadBalancerListenersWithContext is the same as AddLoadBalancerListeners with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) AddLoadBalancerListenersWithContext(ctx context.Context, i *AddLoadBalancerListenersInput) (*AddLoadBalancerListenersOutput, error) {
 	if i == nil {
 		i = &AddLoadBalancerListenersInput{}
 	}
@@ -129,8 +147,12 @@ func (s *LoadBalancerService) AddLoadBalancerListeners(i *AddLoadBalancerListene
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +187,12 @@ type AddLoadBalancerListenersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/add_loadbalancer_policy_rules.html
 func (s *LoadBalancerService) AddLoadBalancerPolicyRules(i *AddLoadBalancerPolicyRulesInput) (*AddLoadBalancerPolicyRulesOutput, error) {
+	return s.AddLoadBalancerPolicyRulesWithContext(context.Background(), i)
+}
+
+// AddLoadBalancerPolicyRulesWithContext is the same as AddLoadBalancerPolicyRules with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) AddLoadBalancerPolicyRulesWithContext(ctx context.Context, i *AddLoadBalancerPolicyRulesInput) (*AddLoadBalancerPolicyRulesOutput, error) {
 	if i == nil {
 		i = &AddLoadBalancerPolicyRulesInput{}
 	}
@@ -180,8 +208,12 @@ func (s *LoadBalancerService) AddLoadBalancerPolicyRules(i *AddLoadBalancerPolic
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +248,12 @@ type AddLoadBalancerPolicyRulesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/apply_loadbalancer_policy.html
 func (s *LoadBalancerService) ApplyLoadBalancerPolicy(i *ApplyLoadBalancerPolicyInput) (*ApplyLoadBalancerPolicyOutput, error) {
+	return s.ApplyLoadBalancerPolicyWithContext(context.Background(), i)
+}
+
+// ApplyLoadBalancerPolicyWithContext is the same as ApplyLoadBalancerPolicy with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) ApplyLoadBalancerPolicyWithContext(ctx context.Context, i *ApplyLoadBalancerPolicyInput) (*ApplyLoadBalancerPolicyOutput, error) {
 	if i == nil {
 		i = &ApplyLoadBalancerPolicyInput{}
 	}
@@ -231,8 +269,12 @@ func (s *LoadBalancerService) ApplyLoadBalancerPolicy(i *ApplyLoadBalancerPolicy
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -265,6 +307,12 @@ type ApplyLoadBalancerPolicyOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/associate_eips_to_loadbalancer.html
 func (s *LoadBalancerService) AssociateEIPsToLoadBalancer(i *AssociateEIPsToLoadBalancerInput) (*AssociateEIPsToLoadBalancerOutput, error) {
+	return s.AssociateEIPsToLoadBalancerWithContext(context.Background(), i)
+}
+
+// AssociateEIPsToLoadBalancerWithContext is the same as AssociateEIPsToLoadBalancer with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) AssociateEIPsToLoadBalancerWithContext(ctx context.Context, i *AssociateEIPsToLoadBalancerInput) (*AssociateEIPsToLoadBalancerOutput, error) {
 	if i == nil {
 		i = &AssociateEIPsToLoadBalancerInput{}
 	}
@@ -280,8 +328,12 @@ func (s *LoadBalancerService) AssociateEIPsToLoadBalancer(i *AssociateEIPsToLoad
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -322,6 +374,12 @@ type AssociateEIPsToLoadBalancerOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/create_loadbalancer.html
 func (s *LoadBalancerService) CreateLoadBalancer(i *CreateLoadBalancerInput) (*CreateLoadBalancerOutput, error) {
+	return s.CreateLoadBalancerWithContext(context.Background(), i)
+}
+
+// CreateLoadBalancerWithContext is the same as CreateLoadBalancer with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) CreateLoadBalancerWithContext(ctx context.Context, i *CreateLoadBalancerInput) (*CreateLoadBalancerOutput, error) {
 	if i == nil {
 		i = &CreateLoadBalancerInput{}
 	}
@@ -337,8 +395,12 @@ func (s *LoadBalancerService) CreateLoadBalancer(i *CreateLoadBalancerInput) (*C
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -440,6 +502,12 @@ type CreateLoadBalancerOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/create_loadbalancer_policy.html
 func (s *LoadBalancerService) CreateLoadBalancerPolicy(i *CreateLoadBalancerPolicyInput) (*CreateLoadBalancerPolicyOutput, error) {
+	return s.CreateLoadBalancerPolicyWithContext(context.Background(), i)
+}
+
+// CreateLoadBalancerPolicyWithContext is the same as CreateLoadBalancerPolicy with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) CreateLoadBalancerPolicyWithContext(ctx context.Context, i *CreateLoadBalancerPolicyInput) (*CreateLoadBalancerPolicyOutput, error) {
 	if i == nil {
 		i = &CreateLoadBalancerPolicyInput{}
 	}
@@ -455,8 +523,12 @@ func (s *LoadBalancerService) CreateLoadBalancerPolicy(i *CreateLoadBalancerPoli
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -511,6 +583,12 @@ type CreateLoadBalancerPolicyOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/create_server_certificate.html
 func (s *LoadBalancerService) CreateServerCertificate(i *CreateServerCertificateInput) (*CreateServerCertificateOutput, error) {
+	return s.CreateServerCertificateWithContext(context.Background(), i)
+}
+
+// CreateServerCertificateWithContext is the same as CreateServerCertificate with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) CreateServerCertificateWithContext(ctx context.Context, i *CreateServerCertificateInput) (*CreateServerCertificateOutput, error) {
 	if i == nil {
 		i = &CreateServerCertificateInput{}
 	}
@@ -526,8 +604,12 @@ func (s *LoadBalancerService) CreateServerCertificate(i *CreateServerCertificate
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -569,6 +651,12 @@ type CreateServerCertificateOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/delete_loadbalancer_backends.html
 func (s *LoadBalancerService) DeleteLoadBalancerBackends(i *DeleteLoadBalancerBackendsInput) (*DeleteLoadBalancerBackendsOutput, error) {
+	return s.DeleteLoadBalancerBackendsWithContext(context.Background(), i)
+}
+
+// DeleteLoadBalancerBackendsWithContext is the same as DeleteLoadBalancerBackends with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DeleteLoadBalancerBackendsWithContext(ctx context.Context, i *DeleteLoadBalancerBackendsInput) (*DeleteLoadBalancerBackendsOutput, error) {
 	if i == nil {
 		i = &DeleteLoadBalancerBackendsInput{}
 	}
@@ -584,8 +672,12 @@ func (s *LoadBalancerService) DeleteLoadBalancerBackends(i *DeleteLoadBalancerBa
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -618,6 +710,12 @@ type DeleteLoadBalancerBackendsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/delete_loadbalancer_listeners.html
 func (s *LoadBalancerService) DeleteLoadBalancerListeners(i *DeleteLoadBalancerListenersInput) (*DeleteLoadBalancerListenersOutput, error) {
+	return s.DeleteLoadBalancerListenersWithContext(context.Background(), i)
+}
+
+// DeleteLoadBalancerListenersWithContext is the same as DeleteLoadBalancerListeners with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DeleteLoadBalancerListenersWithContext(ctx context.Context, i *DeleteLoadBalancerListenersInput) (*DeleteLoadBalancerListenersOutput, error) {
 	if i == nil {
 		i = &DeleteLoadBalancerListenersInput{}
 	}
@@ -633,8 +731,12 @@ func (s *LoadBalancerService) DeleteLoadBalancerListeners(i *DeleteLoadBalancerL
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -667,6 +769,12 @@ type DeleteLoadBalancerListenersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/delete_loadbalancer_policies.html
 func (s *LoadBalancerService) DeleteLoadBalancerPolicies(i *DeleteLoadBalancerPoliciesInput) (*DeleteLoadBalancerPoliciesOutput, error) {
+	return s.DeleteLoadBalancerPoliciesWithContext(context.Background(), i)
+}
+
+// DeleteLoadBalancerPoliciesWithContext is the same as DeleteLoadBalancerPolicies with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DeleteLoadBalancerPoliciesWithContext(ctx context.Context, i *DeleteLoadBalancerPoliciesInput) (*DeleteLoadBalancerPoliciesOutput, error) {
 	if i == nil {
 		i = &DeleteLoadBalancerPoliciesInput{}
 	}
@@ -682,8 +790,12 @@ func (s *LoadBalancerService) DeleteLoadBalancerPolicies(i *DeleteLoadBalancerPo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -716,6 +828,12 @@ type DeleteLoadBalancerPoliciesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/delete_loadbalancer_policy_rules.html
 func (s *LoadBalancerService) DeleteLoadBalancerPolicyRules(i *DeleteLoadBalancerPolicyRulesInput) (*DeleteLoadBalancerPolicyRulesOutput, error) {
+	return s.DeleteLoadBalancerPolicyRulesWithContext(context.Background(), i)
+}
+
+// DeleteLoadBalancerPolicyRulesWithContext is the same as DeleteLoadBalancerPolicyRules with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DeleteLoadBalancerPolicyRulesWithContext(ctx context.Context, i *DeleteLoadBalancerPolicyRulesInput) (*DeleteLoadBalancerPolicyRulesOutput, error) {
 	if i == nil {
 		i = &DeleteLoadBalancerPolicyRulesInput{}
 	}
@@ -731,8 +849,12 @@ func (s *LoadBalancerService) DeleteLoadBalancerPolicyRules(i *DeleteLoadBalance
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -765,6 +887,12 @@ type DeleteLoadBalancerPolicyRulesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/delete_loadbalancers.html
 func (s *LoadBalancerService) DeleteLoadBalancers(i *DeleteLoadBalancersInput) (*DeleteLoadBalancersOutput, error) {
+	return s.DeleteLoadBalancersWithContext(context.Background(), i)
+}
+
+// DeleteLoadBalancersWithContext is the same as DeleteLoadBalancers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DeleteLoadBalancersWithContext(ctx context.Context, i *DeleteLoadBalancersInput) (*DeleteLoadBalancersOutput, error) {
 	if i == nil {
 		i = &DeleteLoadBalancersInput{}
 	}
@@ -780,8 +908,12 @@ func (s *LoadBalancerService) DeleteLoadBalancers(i *DeleteLoadBalancersInput) (
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -815,6 +947,12 @@ type DeleteLoadBalancersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/delete_server_certificates.html
 func (s *LoadBalancerService) DeleteServerCertificates(i *DeleteServerCertificatesInput) (*DeleteServerCertificatesOutput, error) {
+	return s.DeleteServerCertificatesWithContext(context.Background(), i)
+}
+
+// DeleteServerCertificatesWithContext is the same as DeleteServerCertificates with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DeleteServerCertificatesWithContext(ctx context.Context, i *DeleteServerCertificatesInput) (*DeleteServerCertificatesOutput, error) {
 	if i == nil {
 		i = &DeleteServerCertificatesInput{}
 	}
@@ -830,8 +968,12 @@ func (s *LoadBalancerService) DeleteServerCertificates(i *DeleteServerCertificat
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -864,6 +1006,12 @@ type DeleteServerCertificatesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/describe_loadbalancer_backends.html
 func (s *LoadBalancerService) DescribeLoadBalancerBackends(i *DescribeLoadBalancerBackendsInput) (*DescribeLoadBalancerBackendsOutput, error) {
+	return s.DescribeLoadBalancerBackendsWithContext(context.Background(), i)
+}
+
+// DescribeLoadBalancerBackendsWithContext is the same as DescribeLoadBalancerBackends with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DescribeLoadBalancerBackendsWithContext(ctx context.Context, i *DescribeLoadBalancerBackendsInput) (*DescribeLoadBalancerBackendsOutput, error) {
 	if i == nil {
 		i = &DescribeLoadBalancerBackendsInput{}
 	}
@@ -879,8 +1027,12 @@ func (s *LoadBalancerService) DescribeLoadBalancerBackends(i *DescribeLoadBalanc
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -910,8 +1062,67 @@ type DescribeLoadBalancerBackendsOutput struct {
 	RetCode                *int                   `json:"ret_code" name:"ret_code" location:"elements"`
 }
 
+// DescribeLoadBalancerBackendsPaginator pages through the results of DescribeLoadBalancerBackends.
+type DescribeLoadBalancerBackendsPaginator struct {
+	request.Pagination
+	service *LoadBalancerService
+	input   DescribeLoadBalancerBackendsInput
+}
+
+// NewDescribeLoadBalancerBackendsPaginator create a paginator of DescribeLoadBalancerBackends starting at
+// the Offset of input.
+func NewDescribeLoadBalancerBackendsPaginator(s *LoadBalancerService, i *DescribeLoadBalancerBackendsInput) *DescribeLoadBalancerBackendsPaginator {
+	if i == nil {
+		i = &DescribeLoadBalancerBackendsInput{}
+	}
+	return &DescribeLoadBalancerBackendsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeLoadBalancerBackends.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeLoadBalancerBackendsPaginator) NextPage(ctx context.Context) (*DescribeLoadBalancerBackendsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeLoadBalancerBackendsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.LoadBalancerBackendSet), nil)
+	return o, nil
+}
+
+// DescribeLoadBalancerBackendsAll returns the LoadBalancerBackendSet of all the pages of DescribeLoadBalancerBackends
+// starting at the Offset of input.
+func (s *LoadBalancerService) DescribeLoadBalancerBackendsAll(ctx context.Context, i *DescribeLoadBalancerBackendsInput) ([]*LoadBalancerBackend, error) {
+	p := NewDescribeLoadBalancerBackendsPaginator(s, i)
+	set := []*LoadBalancerBackend{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.LoadBalancerBackendSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/lb/describe_loadbalancer_listeners.html
 func (s *LoadBalancerService) DescribeLoadBalancerListeners(i *DescribeLoadBalancerListenersInput) (*DescribeLoadBalancerListenersOutput, error) {
+	return s.DescribeLoadBalancerListenersWithContext(context.Background(), i)
+}
+
+// DescribeLoadBalancerListenersWithContext is the same as DescribeLoadBalancerListeners with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DescribeLoadBalancerListenersWithContext(ctx context.Context, i *DescribeLoadBalancerListenersInput) (*DescribeLoadBalancerListenersOutput, error) {
 	if i == nil {
 		i = &DescribeLoadBalancerListenersInput{}
 	}
@@ -927,8 +1138,12 @@ func (s *LoadBalancerService) DescribeLoadBalancerListeners(i *DescribeLoadBalan
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -958,8 +1173,67 @@ type DescribeLoadBalancerListenersOutput struct {
 	TotalCount              *int                    `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeLoadBalancerListenersPaginator pages through the results of DescribeLoadBalancerListeners.
+type DescribeLoadBalancerListenersPaginator struct {
+	request.Pagination
+	service *LoadBalancerService
+	input   DescribeLoadBalancerListenersInput
+}
+
+// NewDescribeLoadBalancerListenersPaginator create a paginator of DescribeLoadBalancerListeners starting at
+// the Offset of input.
+func NewDescribeLoadBalancerListenersPaginator(s *LoadBalancerService, i *DescribeLoadBalancerListenersInput) *DescribeLoadBalancerListenersPaginator {
+	if i == nil {
+		i = &DescribeLoadBalancerListenersInput{}
+	}
+	return &DescribeLoadBalancerListenersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeLoadBalancerListeners.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeLoadBalancerListenersPaginator) NextPage(ctx context.Context) (*DescribeLoadBalancerListenersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeLoadBalancerListenersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.LoadBalancerListenerSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeLoadBalancerListenersAll returns the LoadBalancerListenerSet of all the pages of DescribeLoadBalancerListeners
+// starting at the Offset of input.
+func (s *LoadBalancerService) DescribeLoadBalancerListenersAll(ctx context.Context, i *DescribeLoadBalancerListenersInput) ([]*LoadBalancerListener, error) {
+	p := NewDescribeLoadBalancerListenersPaginator(s, i)
+	set := []*LoadBalancerListener{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.LoadBalancerListenerSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/lb/describe_loadbalancer_policies.html
 func (s *LoadBalancerService) DescribeLoadBalancerPolicies(i *DescribeLoadBalancerPoliciesInput) (*DescribeLoadBalancerPoliciesOutput, error) {
+	return s.DescribeLoadBalancerPoliciesWithContext(context.Background(), i)
+}
+
+// DescribeLoadBalancerPoliciesWithContext is the same as DescribeLoadBalancerPolicies with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DescribeLoadBalancerPoliciesWithContext(ctx context.Context, i *DescribeLoadBalancerPoliciesInput) (*DescribeLoadBalancerPoliciesOutput, error) {
 	if i == nil {
 		i = &DescribeLoadBalancerPoliciesInput{}
 	}
@@ -975,8 +1249,12 @@ func (s *LoadBalancerService) DescribeLoadBalancerPolicies(i *DescribeLoadBalanc
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1005,8 +1283,67 @@ type DescribeLoadBalancerPoliciesOutput struct {
 	TotalCount            *int                  `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeLoadBalancerPoliciesPaginator pages through the results of DescribeLoadBalancerPolicies.
+type DescribeLoadBalancerPoliciesPaginator struct {
+	request.Pagination
+	service *LoadBalancerService
+	input   DescribeLoadBalancerPoliciesInput
+}
+
+// NewDescribeLoadBalancerPoliciesPaginator create a paginator of DescribeLoadBalancerPolicies starting at
+// the Offset of input.
+func NewDescribeLoadBalancerPoliciesPaginator(s *LoadBalancerService, i *DescribeLoadBalancerPoliciesInput) *DescribeLoadBalancerPoliciesPaginator {
+	if i == nil {
+		i = &DescribeLoadBalancerPoliciesInput{}
+	}
+	return &DescribeLoadBalancerPoliciesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeLoadBalancerPolicies.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeLoadBalancerPoliciesPaginator) NextPage(ctx context.Context) (*DescribeLoadBalancerPoliciesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeLoadBalancerPoliciesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.LoadBalancerPolicySet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeLoadBalancerPoliciesAll returns the LoadBalancerPolicySet of all the pages of DescribeLoadBalancerPolicies
+// starting at the Offset of input.
+func (s *LoadBalancerService) DescribeLoadBalancerPoliciesAll(ctx context.Context, i *DescribeLoadBalancerPoliciesInput) ([]*LoadBalancerPolicy, error) {
+	p := NewDescribeLoadBalancerPoliciesPaginator(s, i)
+	set := []*LoadBalancerPolicy{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.LoadBalancerPolicySet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/lb/describe_loadbalancer_policy_rules.html
 func (s *LoadBalancerService) DescribeLoadBalancerPolicyRules(i *DescribeLoadBalancerPolicyRulesInput) (*DescribeLoadBalancerPolicyRulesOutput, error) {
+	return s.DescribeLoadBalancerPolicyRulesWithContext(context.Background(), i)
+}
+
+// DescribeLoadBalancerPolicyRulesWithContext is the same as DescribeLoadBalancerPolicyRules with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DescribeLoadBalancerPolicyRulesWithContext(ctx context.Context, i *DescribeLoadBalancerPolicyRulesInput) (*DescribeLoadBalancerPolicyRulesOutput, error) {
 	if i == nil {
 		i = &DescribeLoadBalancerPolicyRulesInput{}
 	}
@@ -1022,8 +1359,12 @@ func (s *LoadBalancerService) DescribeLoadBalancerPolicyRules(i *DescribeLoadBal
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1052,8 +1393,67 @@ type DescribeLoadBalancerPolicyRulesOutput struct {
 	TotalCount                *int                      `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeLoadBalancerPolicyRulesPaginator pages through the results of DescribeLoadBalancerPolicyRules.
+type DescribeLoadBalancerPolicyRulesPaginator struct {
+	request.Pagination
+	service *LoadBalancerService
+	input   DescribeLoadBalancerPolicyRulesInput
+}
+
+// NewDescribeLoadBalancerPolicyRulesPaginator create a paginator of DescribeLoadBalancerPolicyRules starting at
+// the Offset of input.
+func NewDescribeLoadBalancerPolicyRulesPaginator(s *LoadBalancerService, i *DescribeLoadBalancerPolicyRulesInput) *DescribeLoadBalancerPolicyRulesPaginator {
+	if i == nil {
+		i = &DescribeLoadBalancerPolicyRulesInput{}
+	}
+	return &DescribeLoadBalancerPolicyRulesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeLoadBalancerPolicyRules.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeLoadBalancerPolicyRulesPaginator) NextPage(ctx context.Context) (*DescribeLoadBalancerPolicyRulesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeLoadBalancerPolicyRulesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.LoadBalancerPolicyRuleSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeLoadBalancerPolicyRulesAll returns the LoadBalancerPolicyRuleSet of all the pages of DescribeLoadBalancerPolicyRules
+// starting at the Offset of input.
+func (s *LoadBalancerService) DescribeLoadBalancerPolicyRulesAll(ctx context.Context, i *DescribeLoadBalancerPolicyRulesInput) ([]*LoadBalancerPolicyRule, error) {
+	p := NewDescribeLoadBalancerPolicyRulesPaginator(s, i)
+	set := []*LoadBalancerPolicyRule{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.LoadBalancerPolicyRuleSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/lb/describe_loadbalancers.html
 func (s *LoadBalancerService) DescribeLoadBalancers(i *DescribeLoadBalancersInput) (*DescribeLoadBalancersOutput, error) {
+	return s.DescribeLoadBalancersWithContext(context.Background(), i)
+}
+
+// DescribeLoadBalancersWithContext is the same as DescribeLoadBalancers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DescribeLoadBalancersWithContext(ctx context.Context, i *DescribeLoadBalancersInput) (*DescribeLoadBalancersOutput, error) {
 	if i == nil {
 		i = &DescribeLoadBalancersInput{}
 	}
@@ -1069,8 +1469,12 @@ func (s *LoadBalancerService) DescribeLoadBalancers(i *DescribeLoadBalancersInpu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1101,8 +1505,99 @@ type DescribeLoadBalancersOutput struct {
 	RetCode         *int            `json:"ret_code" name:"ret_code" location:"elements"`
 }
 
+// DescribeLoadBalancersPaginator pages through the results of DescribeLoadBalancers.
+type DescribeLoadBalancersPaginator struct {
+	request.Pagination
+	service *LoadBalancerService
+	input   DescribeLoadBalancersInput
+}
+
+// NewDescribeLoadBalancersPaginator create a paginator of DescribeLoadBalancers starting at
+// the Offset of input.
+func NewDescribeLoadBalancersPaginator(s *LoadBalancerService, i *DescribeLoadBalancersInput) *DescribeLoadBalancersPaginator {
+	if i == nil {
+		i = &DescribeLoadBalancersInput{}
+	}
+	return &DescribeLoadBalancersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeLoadBalancers.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeLoadBalancersPaginator) NextPage(ctx context.Context) (*DescribeLoadBalancersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeLoadBalancersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.LoadBalancerSet), nil)
+	return o, nil
+}
+
+// DescribeLoadBalancersAll returns the LoadBalancerSet of all the pages of DescribeLoadBalancers
+// starting at the Offset of input.
+func (s *LoadBalancerService) DescribeLoadBalancersAll(ctx context.Context, i *DescribeLoadBalancersInput) ([]*LoadBalancer, error) {
+	p := NewDescribeLoadBalancersPaginator(s, i)
+	set := []*LoadBalancer{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.LoadBalancerSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilLoadBalancersStatus waits until all the given LoadBalancers are in the
+// status without transition status, it fails if any of them is not found.
+func (s *LoadBalancerService) WaitUntilLoadBalancersStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*LoadBalancer, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilLoadBalancersStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeLoadBalancersAll(ctx, &DescribeLoadBalancersInput{
+				LoadBalancers: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeLoadBalancersOutput{LoadBalancerSet: set}, nil
+		},
+		StatusPath:           "LoadBalancerSet[].Status",
+		TransitionStatusPath: "LoadBalancerSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeLoadBalancersOutput).LoadBalancerSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/lb/describe_server_certificates.html
 func (s *LoadBalancerService) DescribeServerCertificates(i *DescribeServerCertificatesInput) (*DescribeServerCertificatesOutput, error) {
+	return s.DescribeServerCertificatesWithContext(context.Background(), i)
+}
+
+// DescribeServerCertificatesWithContext is the same as DescribeServerCertificates with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DescribeServerCertificatesWithContext(ctx context.Context, i *DescribeServerCertificatesInput) (*DescribeServerCertificatesOutput, error) {
 	if i == nil {
 		i = &DescribeServerCertificatesInput{}
 	}
@@ -1118,8 +1613,12 @@ func (s *LoadBalancerService) DescribeServerCertificates(i *DescribeServerCertif
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1149,8 +1648,67 @@ type DescribeServerCertificatesOutput struct {
 	TotalCount           *int                 `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeServerCertificatesPaginator pages through the results of DescribeServerCertificates.
+type DescribeServerCertificatesPaginator struct {
+	request.Pagination
+	service *LoadBalancerService
+	input   DescribeServerCertificatesInput
+}
+
+// NewDescribeServerCertificatesPaginator create a paginator of DescribeServerCertificates starting at
+// the Offset of input.
+func NewDescribeServerCertificatesPaginator(s *LoadBalancerService, i *DescribeServerCertificatesInput) *DescribeServerCertificatesPaginator {
+	if i == nil {
+		i = &DescribeServerCertificatesInput{}
+	}
+	return &DescribeServerCertificatesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeServerCertificates.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeServerCertificatesPaginator) NextPage(ctx context.Context) (*DescribeServerCertificatesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeServerCertificatesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.ServerCertificateSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeServerCertificatesAll returns the ServerCertificateSet of all the pages of DescribeServerCertificates
+// starting at the Offset of input.
+func (s *LoadBalancerService) DescribeServerCertificatesAll(ctx context.Context, i *DescribeServerCertificatesInput) ([]*ServerCertificate, error) {
+	p := NewDescribeServerCertificatesPaginator(s, i)
+	set := []*ServerCertificate{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.ServerCertificateSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/lb/dissociate_eips_from_loadbalancer.html
 func (s *LoadBalancerService) DissociateEIPsFromLoadBalancer(i *DissociateEIPsFromLoadBalancerInput) (*DissociateEIPsFromLoadBalancerOutput, error) {
+	return s.DissociateEIPsFromLoadBalancerWithContext(context.Background(), i)
+}
+
+// DissociateEIPsFromLoadBalancerWithContext is the same as DissociateEIPsFromLoadBalancer with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) DissociateEIPsFromLoadBalancerWithContext(ctx context.Context, i *DissociateEIPsFromLoadBalancerInput) (*DissociateEIPsFromLoadBalancerOutput, error) {
 	if i == nil {
 		i = &DissociateEIPsFromLoadBalancerInput{}
 	}
@@ -1166,8 +1724,12 @@ func (s *LoadBalancerService) DissociateEIPsFromLoadBalancer(i *DissociateEIPsFr
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1208,6 +1770,12 @@ type DissociateEIPsFromLoadBalancerOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/monitor/get_loadbalancer_monitor.html
 func (s *LoadBalancerService) GetLoadBalancerMonitor(i *GetLoadBalancerMonitorInput) (*GetLoadBalancerMonitorOutput, error) {
+	return s.GetLoadBalancerMonitorWithContext(context.Background(), i)
+}
+
+// GetLoadBalancerMonitorWithContext is the same as GetLoadBalancerMonitor with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) GetLoadBalancerMonitorWithContext(ctx context.Context, i *GetLoadBalancerMonitorInput) (*GetLoadBalancerMonitorOutput, error) {
 	if i == nil {
 		i = &GetLoadBalancerMonitorInput{}
 	}
@@ -1223,8 +1791,12 @@ func (s *LoadBalancerService) GetLoadBalancerMonitor(i *GetLoadBalancerMonitorIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1298,6 +1870,12 @@ type GetLoadBalancerMonitorOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/modify_loadbalancer_attributes.html
 func (s *LoadBalancerService) ModifyLoadBalancerAttributes(i *ModifyLoadBalancerAttributesInput) (*ModifyLoadBalancerAttributesOutput, error) {
+	return s.ModifyLoadBalancerAttributesWithContext(context.Background(), i)
+}
+
+// ModifyLoadBalancerAttributesWithContext is the same as ModifyLoadBalancerAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) ModifyLoadBalancerAttributesWithContext(ctx context.Context, i *ModifyLoadBalancerAttributesInput) (*ModifyLoadBalancerAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyLoadBalancerAttributesInput{}
 	}
@@ -1313,8 +1891,12 @@ func (s *LoadBalancerService) ModifyLoadBalancerAttributes(i *ModifyLoadBalancer
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1352,6 +1934,12 @@ type ModifyLoadBalancerAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/modify_loadbalancer_backend_attributes.html
 func (s *LoadBalancerService) ModifyLoadBalancerBackendAttributes(i *ModifyLoadBalancerBackendAttributesInput) (*ModifyLoadBalancerBackendAttributesOutput, error) {
+	return s.ModifyLoadBalancerBackendAttributesWithContext(context.Background(), i)
+}
+
+// ModifyLoadBalancerBackendAttributesWithContext is the same as ModifyLoadBalancerBackendAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) ModifyLoadBalancerBackendAttributesWithContext(ctx context.Context, i *ModifyLoadBalancerBackendAttributesInput) (*ModifyLoadBalancerBackendAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyLoadBalancerBackendAttributesInput{}
 	}
@@ -1367,8 +1955,12 @@ func (s *LoadBalancerService) ModifyLoadBalancerBackendAttributes(i *ModifyLoadB
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1420,6 +2012,12 @@ type ModifyLoadBalancerBackendAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/modify_loadbalancer_listener_attributes.html
 func (s *LoadBalancerService) ModifyLoadBalancerListenerAttributes(i *ModifyLoadBalancerListenerAttributesInput) (*ModifyLoadBalancerListenerAttributesOutput, error) {
+	return s.ModifyLoadBalancerListenerAttributesWithContext(context.Background(), i)
+}
+
+// ModifyLoadBalancerListenerAttributesWithContext is the same as ModifyLoadBalancerListenerAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) ModifyLoadBalancerListenerAttributesWithContext(ctx context.Context, i *ModifyLoadBalancerListenerAttributesInput) (*ModifyLoadBalancerListenerAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyLoadBalancerListenerAttributesInput{}
 	}
@@ -1435,8 +2033,12 @@ func (s *LoadBalancerService) ModifyLoadBalancerListenerAttributes(i *ModifyLoad
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1499,6 +2101,12 @@ type ModifyLoadBalancerListenerAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/modify_loadbalancer_policy_attributes.html
 func (s *LoadBalancerService) ModifyLoadBalancerPolicyAttributes(i *ModifyLoadBalancerPolicyAttributesInput) (*ModifyLoadBalancerPolicyAttributesOutput, error) {
+	return s.ModifyLoadBalancerPolicyAttributesWithContext(context.Background(), i)
+}
+
+// ModifyLoadBalancerPolicyAttributesWithContext is the same as ModifyLoadBalancerPolicyAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) ModifyLoadBalancerPolicyAttributesWithContext(ctx context.Context, i *ModifyLoadBalancerPolicyAttributesInput) (*ModifyLoadBalancerPolicyAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyLoadBalancerPolicyAttributesInput{}
 	}
@@ -1514,8 +2122,12 @@ func (s *LoadBalancerService) ModifyLoadBalancerPolicyAttributes(i *ModifyLoadBa
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1550,6 +2162,12 @@ type ModifyLoadBalancerPolicyAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/modify_loadbalancer_policy_rule_attributes.html
 func (s *LoadBalancerService) ModifyLoadBalancerPolicyRuleAttributes(i *ModifyLoadBalancerPolicyRuleAttributesInput) (*ModifyLoadBalancerPolicyRuleAttributesOutput, error) {
+	return s.ModifyLoadBalancerPolicyRuleAttributesWithContext(context.Background(), i)
+}
+
+// ModifyLoadBalancerPolicyRuleAttributesWithContext is the same as ModifyLoadBalancerPolicyRuleAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) ModifyLoadBalancerPolicyRuleAttributesWithContext(ctx context.Context, i *ModifyLoadBalancerPolicyRuleAttributesInput) (*ModifyLoadBalancerPolicyRuleAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyLoadBalancerPolicyRuleAttributesInput{}
 	}
@@ -1565,8 +2183,12 @@ func (s *LoadBalancerService) ModifyLoadBalancerPolicyRuleAttributes(i *ModifyLo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1601,6 +2223,12 @@ type ModifyLoadBalancerPolicyRuleAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/modify_server_certificate_attributes.html
 func (s *LoadBalancerService) ModifyServerCertificateAttributes(i *ModifyServerCertificateAttributesInput) (*ModifyServerCertificateAttributesOutput, error) {
+	return s.ModifyServerCertificateAttributesWithContext(context.Background(), i)
+}
+
+// ModifyServerCertificateAttributesWithContext is the same as ModifyServerCertificateAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) ModifyServerCertificateAttributesWithContext(ctx context.Context, i *ModifyServerCertificateAttributesInput) (*ModifyServerCertificateAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyServerCertificateAttributesInput{}
 	}
@@ -1616,8 +2244,12 @@ func (s *LoadBalancerService) ModifyServerCertificateAttributes(i *ModifyServerC
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1651,6 +2283,12 @@ type ModifyServerCertificateAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/resize_loadbalancers.html
 func (s *LoadBalancerService) ResizeLoadBalancers(i *ResizeLoadBalancersInput) (*ResizeLoadBalancersOutput, error) {
+	return s.ResizeLoadBalancersWithContext(context.Background(), i)
+}
+
+// ResizeLoadBalancersWithContext is the same as ResizeLoadBalancers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) ResizeLoadBalancersWithContext(ctx context.Context, i *ResizeLoadBalancersInput) (*ResizeLoadBalancersOutput, error) {
 	if i == nil {
 		i = &ResizeLoadBalancersInput{}
 	}
@@ -1666,8 +2304,12 @@ func (s *LoadBalancerService) ResizeLoadBalancers(i *ResizeLoadBalancersInput) (
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1716,6 +2358,12 @@ type ResizeLoadBalancersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/start_loadbalancers.html
 func (s *LoadBalancerService) StartLoadBalancers(i *StartLoadBalancersInput) (*StartLoadBalancersOutput, error) {
+	return s.StartLoadBalancersWithContext(context.Background(), i)
+}
+
+// StartLoadBalancersWithContext is the same as StartLoadBalancers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) StartLoadBalancersWithContext(ctx context.Context, i *StartLoadBalancersInput) (*StartLoadBalancersOutput, error) {
 	if i == nil {
 		i = &StartLoadBalancersInput{}
 	}
@@ -1731,8 +2379,12 @@ func (s *LoadBalancerService) StartLoadBalancers(i *StartLoadBalancersInput) (*S
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1765,6 +2417,12 @@ type StartLoadBalancersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/stop_loadbalancers.html
 func (s *LoadBalancerService) StopLoadBalancers(i *StopLoadBalancersInput) (*StopLoadBalancersOutput, error) {
+	return s.StopLoadBalancersWithContext(context.Background(), i)
+}
+
+// StopLoadBalancersWithContext is the same as StopLoadBalancers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) StopLoadBalancersWithContext(ctx context.Context, i *StopLoadBalancersInput) (*StopLoadBalancersOutput, error) {
 	if i == nil {
 		i = &StopLoadBalancersInput{}
 	}
@@ -1780,8 +2438,12 @@ func (s *LoadBalancerService) StopLoadBalancers(i *StopLoadBalancersInput) (*Sto
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1814,6 +2476,12 @@ type StopLoadBalancersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/lb/update_loadbalancers.html
 func (s *LoadBalancerService) UpdateLoadBalancers(i *UpdateLoadBalancersInput) (*UpdateLoadBalancersOutput, error) {
+	return s.UpdateLoadBalancersWithContext(context.Background(), i)
+}
+
+// UpdateLoadBalancersWithContext is the same as UpdateLoadBalancers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *LoadBalancerService) UpdateLoadBalancersWithContext(ctx context.Context, i *UpdateLoadBalancersInput) (*UpdateLoadBalancersOutput, error) {
 	if i == nil {
 		i = &UpdateLoadBalancersInput{}
 	}
@@ -1829,8 +2497,12 @@ func (s *LoadBalancerService) UpdateLoadBalancers(i *UpdateLoadBalancersInput) (
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
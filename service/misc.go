@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type MiscService struct {
 	Config     *config.Config
 	Properties *MiscServiceProperties
+	Handlers   *request.Handlers
 }
 
 type MiscServiceProperties struct {
@@ -40,11 +42,17 @@ type MiscServiceProperties struct {
 func (s *QingCloudService) Misc() (*MiscService, error) {
 	properties := &MiscServiceProperties{}
 
-	return &MiscService{Config: s.Config, Properties: properties}, nil
+	return &MiscService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/product/api/action/misc/get_quota_left.html
 func (s *MiscService) GetQuotaLeft(i *GetQuotaLeftInput) (*GetQuotaLeftOutput, error) {
+	return s.GetQuotaLeftWithContext(context.Background(), i)
+}
+
+// GetQuotaLeftWithContext is the same as GetQuotaLeft with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MiscService) GetQuotaLeftWithContext(ctx context.Context, i *GetQuotaLeftInput) (*GetQuotaLeftOutput, error) {
 	if i == nil {
 		i = &GetQuotaLeftInput{}
 	}
@@ -60,8 +68,12 @@ func (s *MiscService) GetQuotaLeft(i *GetQuotaLeftInput) (*GetQuotaLeftOutput, e
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +107,12 @@ type GetQuotaLeftOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/product/api/action/misc
 func (s *MiscService) GetResourceLimit(i *GetResourceLimitInput) (*GetResourceLimitOutput, error) {
+	return s.GetResourceLimitWithContext(context.Background(), i)
+}
+
+// GetResourceLimitWithContext is the same as GetResourceLimit with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MiscService) GetResourceLimitWithContext(ctx context.Context, i *GetResourceLimitInput) (*GetResourceLimitOutput, error) {
 	if i == nil {
 		i = &GetResourceLimitInput{}
 	}
@@ -110,8 +128,12 @@ func (s *MiscService) GetResourceLimit(i *GetResourceLimitInput) (*GetResourceLi
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type MongoService struct {
 	Config     *config.Config
 	Properties *MongoServiceProperties
+	Handlers   *request.Handlers
 }
 
 type MongoServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Mongo(zone string) (*MongoService, error) {
 		Zone: &zone,
 	}
 
-	return &MongoService{Config: s.Config, Properties: properties}, nil
+	return &MongoService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/add_mongo_instances.html
 func (s *MongoService) AddMongoInstances(i *AddMongoInstancesInput) (*AddMongoInstancesOutput, error) {
+	return s.AddMongoInstancesWithContext(context.Background(), i)
+}
+
+// AddMongoInstancesWithContext is the same as AddMongoInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) AddMongoInstancesWithContext(ctx context.Context, i *AddMongoInstancesInput) (*AddMongoInstancesOutput, error) {
 	if i == nil {
 		i = &AddMongoInstancesInput{}
 	}
@@ -64,8 +72,12 @@ func (s *MongoService) AddMongoInstances(i *AddMongoInstancesInput) (*AddMongoIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +115,12 @@ type AddMongoInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/change_mongo_vxnet.html
 func (s *MongoService) ChangeMongoVxNet(i *ChangeMongoVxNetInput) (*ChangeMongoVxNetOutput, error) {
+	return s.ChangeMongoVxNetWithContext(context.Background(), i)
+}
+
+// ChangeMongoVxNetWithContext is the same as ChangeMongoVxNet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) ChangeMongoVxNetWithContext(ctx context.Context, i *ChangeMongoVxNetInput) (*ChangeMongoVxNetOutput, error) {
 	if i == nil {
 		i = &ChangeMongoVxNetInput{}
 	}
@@ -118,8 +136,12 @@ func (s *MongoService) ChangeMongoVxNet(i *ChangeMongoVxNetInput) (*ChangeMongoV
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +192,12 @@ type ChangeMongoVxNetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/create_mongo.html
 func (s *MongoService) CreateMongo(i *CreateMongoInput) (*CreateMongoOutput, error) {
+	return s.CreateMongoWithContext(context.Background(), i)
+}
+
+// CreateMongoWithContext is the same as CreateMongo with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) CreateMongoWithContext(ctx context.Context, i *CreateMongoInput) (*CreateMongoOutput, error) {
 	if i == nil {
 		i = &CreateMongoInput{}
 	}
@@ -185,8 +213,12 @@ func (s *MongoService) CreateMongo(i *CreateMongoInput) (*CreateMongoOutput, err
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -252,6 +284,12 @@ type CreateMongoOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/create_mongo_from_snapshot.html
 func (s *MongoService) CreateMongoFromSnapshot(i *CreateMongoFromSnapshotInput) (*CreateMongoFromSnapshotOutput, error) {
+	return s.CreateMongoFromSnapshotWithContext(context.Background(), i)
+}
+
+// CreateMongoFromSnapshotWithContext is the same as CreateMongoFromSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) CreateMongoFromSnapshotWithContext(ctx context.Context, i *CreateMongoFromSnapshotInput) (*CreateMongoFromSnapshotOutput, error) {
 	if i == nil {
 		i = &CreateMongoFromSnapshotInput{}
 	}
@@ -267,8 +305,12 @@ func (s *MongoService) CreateMongoFromSnapshot(i *CreateMongoFromSnapshotInput)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -302,6 +344,12 @@ type CreateMongoFromSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/delete_mongos.html
 func (s *MongoService) DeleteMongos(i *DeleteMongosInput) (*DeleteMongosOutput, error) {
+	return s.DeleteMongosWithContext(context.Background(), i)
+}
+
+// DeleteMongosWithContext is the same as DeleteMongos with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) DeleteMongosWithContext(ctx context.Context, i *DeleteMongosInput) (*DeleteMongosOutput, error) {
 	if i == nil {
 		i = &DeleteMongosInput{}
 	}
@@ -317,8 +365,12 @@ func (s *MongoService) DeleteMongos(i *DeleteMongosInput) (*DeleteMongosOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -352,6 +404,12 @@ type DeleteMongosOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/describe_mongo_nodes.html
 func (s *MongoService) DescribeMongoNodes(i *DescribeMongoNodesInput) (*DescribeMongoNodesOutput, error) {
+	return s.DescribeMongoNodesWithContext(context.Background(), i)
+}
+
+// DescribeMongoNodesWithContext is the same as DescribeMongoNodes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) DescribeMongoNodesWithContext(ctx context.Context, i *DescribeMongoNodesInput) (*DescribeMongoNodesOutput, error) {
 	if i == nil {
 		i = &DescribeMongoNodesInput{}
 	}
@@ -367,8 +425,12 @@ func (s *MongoService) DescribeMongoNodes(i *DescribeMongoNodesInput) (*Describe
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -403,8 +465,67 @@ type DescribeMongoNodesOutput struct {
 	TotalCount   *int         `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeMongoNodesPaginator pages through the results of DescribeMongoNodes.
+type DescribeMongoNodesPaginator struct {
+	request.Pagination
+	service *MongoService
+	input   DescribeMongoNodesInput
+}
+
+// NewDescribeMongoNodesPaginator create a paginator of DescribeMongoNodes starting at
+// the Offset of input.
+func NewDescribeMongoNodesPaginator(s *MongoService, i *DescribeMongoNodesInput) *DescribeMongoNodesPaginator {
+	if i == nil {
+		i = &DescribeMongoNodesInput{}
+	}
+	return &DescribeMongoNodesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeMongoNodes.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeMongoNodesPaginator) NextPage(ctx context.Context) (*DescribeMongoNodesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeMongoNodesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.MongoNodeSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeMongoNodesAll returns the MongoNodeSet of all the pages of DescribeMongoNodes
+// starting at the Offset of input.
+func (s *MongoService) DescribeMongoNodesAll(ctx context.Context, i *DescribeMongoNodesInput) ([]*MongoNode, error) {
+	p := NewDescribeMongoNodesPaginator(s, i)
+	set := []*MongoNode{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.MongoNodeSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/mongo/describe_mongo_parameters.html
 func (s *MongoService) DescribeMongoParameters(i *DescribeMongoParametersInput) (*DescribeMongoParametersOutput, error) {
+	return s.DescribeMongoParametersWithContext(context.Background(), i)
+}
+
+// DescribeMongoParametersWithContext is the same as DescribeMongoParameters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) DescribeMongoParametersWithContext(ctx context.Context, i *DescribeMongoParametersInput) (*DescribeMongoParametersOutput, error) {
 	if i == nil {
 		i = &DescribeMongoParametersInput{}
 	}
@@ -420,8 +541,12 @@ func (s *MongoService) DescribeMongoParameters(i *DescribeMongoParametersInput)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -455,8 +580,67 @@ type DescribeMongoParametersOutput struct {
 	TotalCount   *int              `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeMongoParametersPaginator pages through the results of DescribeMongoParameters.
+type DescribeMongoParametersPaginator struct {
+	request.Pagination
+	service *MongoService
+	input   DescribeMongoParametersInput
+}
+
+// NewDescribeMongoParametersPaginator create a paginator of DescribeMongoParameters starting at
+// the Offset of input.
+func NewDescribeMongoParametersPaginator(s *MongoService, i *DescribeMongoParametersInput) *DescribeMongoParametersPaginator {
+	if i == nil {
+		i = &DescribeMongoParametersInput{}
+	}
+	return &DescribeMongoParametersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeMongoParameters.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeMongoParametersPaginator) NextPage(ctx context.Context) (*DescribeMongoParametersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeMongoParametersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.ParameterSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeMongoParametersAll returns the ParameterSet of all the pages of DescribeMongoParameters
+// starting at the Offset of input.
+func (s *MongoService) DescribeMongoParametersAll(ctx context.Context, i *DescribeMongoParametersInput) ([]*MongoParameter, error) {
+	p := NewDescribeMongoParametersPaginator(s, i)
+	set := []*MongoParameter{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.ParameterSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/mongo/describe_mongos.html
 func (s *MongoService) DescribeMongos(i *DescribeMongosInput) (*DescribeMongosOutput, error) {
+	return s.DescribeMongosWithContext(context.Background(), i)
+}
+
+// DescribeMongosWithContext is the same as DescribeMongos with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) DescribeMongosWithContext(ctx context.Context, i *DescribeMongosInput) (*DescribeMongosOutput, error) {
 	if i == nil {
 		i = &DescribeMongosInput{}
 	}
@@ -472,8 +656,12 @@ func (s *MongoService) DescribeMongos(i *DescribeMongosInput) (*DescribeMongosOu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -505,8 +693,99 @@ type DescribeMongosOutput struct {
 	TotalCount *int     `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeMongosPaginator pages through the results of DescribeMongos.
+type DescribeMongosPaginator struct {
+	request.Pagination
+	service *MongoService
+	input   DescribeMongosInput
+}
+
+// NewDescribeMongosPaginator create a paginator of DescribeMongos starting at
+// the Offset of input.
+func NewDescribeMongosPaginator(s *MongoService, i *DescribeMongosInput) *DescribeMongosPaginator {
+	if i == nil {
+		i = &DescribeMongosInput{}
+	}
+	return &DescribeMongosPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeMongos.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeMongosPaginator) NextPage(ctx context.Context) (*DescribeMongosOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeMongosWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.MongoSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeMongosAll returns the MongoSet of all the pages of DescribeMongos
+// starting at the Offset of input.
+func (s *MongoService) DescribeMongosAll(ctx context.Context, i *DescribeMongosInput) ([]*Mongo, error) {
+	p := NewDescribeMongosPaginator(s, i)
+	set := []*Mongo{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.MongoSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilMongosStatus waits until all the given Mongos are in the
+// status without transition status, it fails if any of them is not found.
+func (s *MongoService) WaitUntilMongosStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*Mongo, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilMongosStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeMongosAll(ctx, &DescribeMongosInput{
+				Mongos: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeMongosOutput{MongoSet: set}, nil
+		},
+		StatusPath:           "MongoSet[].Status",
+		TransitionStatusPath: "MongoSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeMongosOutput).MongoSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/monitor/get_mongo_monitor.html
 func (s *MongoService) GetMongoMonitor(i *GetMongoMonitorInput) (*GetMongoMonitorOutput, error) {
+	return s.GetMongoMonitorWithContext(context.Background(), i)
+}
+
+// GetMongoMonitorWithContext is the same as GetMongoMonitor with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) GetMongoMonitorWithContext(ctx context.Context, i *GetMongoMonitorInput) (*GetMongoMonitorOutput, error) {
 	if i == nil {
 		i = &GetMongoMonitorInput{}
 	}
@@ -522,8 +801,12 @@ func (s *MongoService) GetMongoMonitor(i *GetMongoMonitorInput) (*GetMongoMonito
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -596,6 +879,12 @@ type GetMongoMonitorOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/modify_mongo_attributes.html
 func (s *MongoService) ModifyMongoAttributes(i *ModifyMongoAttributesInput) (*ModifyMongoAttributesOutput, error) {
+	return s.ModifyMongoAttributesWithContext(context.Background(), i)
+}
+
+// ModifyMongoAttributesWithContext is the same as ModifyMongoAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) ModifyMongoAttributesWithContext(ctx context.Context, i *ModifyMongoAttributesInput) (*ModifyMongoAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyMongoAttributesInput{}
 	}
@@ -611,8 +900,12 @@ func (s *MongoService) ModifyMongoAttributes(i *ModifyMongoAttributesInput) (*Mo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -648,6 +941,12 @@ type ModifyMongoAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/modify_mongo_instances.html
 func (s *MongoService) ModifyMongoInstances(i *ModifyMongoInstancesInput) (*ModifyMongoInstancesOutput, error) {
+	return s.ModifyMongoInstancesWithContext(context.Background(), i)
+}
+
+// ModifyMongoInstancesWithContext is the same as ModifyMongoInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) ModifyMongoInstancesWithContext(ctx context.Context, i *ModifyMongoInstancesInput) (*ModifyMongoInstancesOutput, error) {
 	if i == nil {
 		i = &ModifyMongoInstancesInput{}
 	}
@@ -663,8 +962,12 @@ func (s *MongoService) ModifyMongoInstances(i *ModifyMongoInstancesInput) (*Modi
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -707,6 +1010,12 @@ type ModifyMongoInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/remove_mongo_instances.html
 func (s *MongoService) RemoveMongoInstances(i *RemoveMongoInstancesInput) (*RemoveMongoInstancesOutput, error) {
+	return s.RemoveMongoInstancesWithContext(context.Background(), i)
+}
+
+// RemoveMongoInstancesWithContext is the same as RemoveMongoInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) RemoveMongoInstancesWithContext(ctx context.Context, i *RemoveMongoInstancesInput) (*RemoveMongoInstancesOutput, error) {
 	if i == nil {
 		i = &RemoveMongoInstancesInput{}
 	}
@@ -722,8 +1031,12 @@ func (s *MongoService) RemoveMongoInstances(i *RemoveMongoInstancesInput) (*Remo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -765,6 +1078,12 @@ type RemoveMongoInstancesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/resize_mongos.html
 func (s *MongoService) ResizeMongos(i *ResizeMongosInput) (*ResizeMongosOutput, error) {
+	return s.ResizeMongosWithContext(context.Background(), i)
+}
+
+// ResizeMongosWithContext is the same as ResizeMongos with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) ResizeMongosWithContext(ctx context.Context, i *ResizeMongosInput) (*ResizeMongosOutput, error) {
 	if i == nil {
 		i = &ResizeMongosInput{}
 	}
@@ -780,8 +1099,12 @@ func (s *MongoService) ResizeMongos(i *ResizeMongosInput) (*ResizeMongosOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -817,6 +1140,12 @@ type ResizeMongosOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/start_mongos.html
 func (s *MongoService) StartMongos(i *StartMongosInput) (*StartMongosOutput, error) {
+	return s.StartMongosWithContext(context.Background(), i)
+}
+
+// StartMongosWithContext is the same as StartMongos with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) StartMongosWithContext(ctx context.Context, i *StartMongosInput) (*StartMongosOutput, error) {
 	if i == nil {
 		i = &StartMongosInput{}
 	}
@@ -832,8 +1161,12 @@ func (s *MongoService) StartMongos(i *StartMongosInput) (*StartMongosOutput, err
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -866,6 +1199,12 @@ type StartMongosOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/mongo/stop_mongos.html
 func (s *MongoService) StopMongos(i *StopMongosInput) (*StopMongosOutput, error) {
+	return s.StopMongosWithContext(context.Background(), i)
+}
+
+// StopMongosWithContext is the same as StopMongos with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MongoService) StopMongosWithContext(ctx context.Context, i *StopMongosInput) (*StopMongosOutput, error) {
 	if i == nil {
 		i = &StopMongosInput{}
 	}
@@ -881,8 +1220,12 @@ func (s *MongoService) StopMongos(i *StopMongosInput) (*StopMongosOutput, error)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type MonitorService struct {
 	Config     *config.Config
 	Properties *MonitorServiceProperties
+	Handlers   *request.Handlers
 }
 
 type MonitorServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Monitor(zone string) (*MonitorService, error) {
 		Zone: &zone,
 	}
 
-	return &MonitorService{Config: s.Config, Properties: properties}, nil
+	return &MonitorService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/monitor/get_monitor.html
 func (s *MonitorService) GetMonitor(i *GetMonitorInput) (*GetMonitorOutput, error) {
+	return s.GetMonitorWithContext(context.Background(), i)
+}
+
+// GetMonitorWithContext is the same as GetMonitor with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *MonitorService) GetMonitorWithContext(ctx context.Context, i *GetMonitorInput) (*GetMonitorOutput, error) {
 	if i == nil {
 		i = &GetMonitorInput{}
 	}
@@ -64,8 +72,12 @@ func (s *MonitorService) GetMonitor(i *GetMonitorInput) (*GetMonitorOutput, erro
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
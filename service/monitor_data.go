@@ -0,0 +1,132 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package service
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// MonitorSteps maps the steps of monitor APIs to their durations.
+var MonitorSteps = map[string]time.Duration{
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"2h":  2 * time.Hour,
+	"1d":  24 * time.Hour,
+}
+
+// Point is a point of a monitor time series, single value meters have one
+// value and multi-value meters, such as disk read and write, have many.
+// Missing values are NaN.
+type Point struct {
+	Time   time.Time
+	Values []float64
+}
+
+// MonitorStep returns the duration of the given monitor step, such as "5m".
+func MonitorStep(step string) (time.Duration, error) {
+	duration, ok := MonitorSteps[step]
+	if !ok {
+		return 0, fmt.Errorf("unknown monitor step \"%s\"", step)
+	}
+	return duration, nil
+}
+
+// Points decodes the data of the meter, see DecodeMeterData.
+func (v *Meter) Points(startTime time.Time, step string) ([]Point, error) {
+	duration, err := MonitorStep(step)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeMeterData(v.Data, startTime, duration)
+}
+
+// DecodeMeterData expands the compressed data returned by monitor APIs.
+//
+// The first point is [timestamp, value], and every following point is either
+// a bare value, which is one step after the previous point, or
+// [interval, value] if there's a gap, which is interval seconds after the
+// previous point. Values of multi-value meters are arrays. The first point
+// is placed at startTime if it carries no timestamp.
+func DecodeMeterData(data []interface{}, startTime time.Time, step time.Duration) ([]Point, error) {
+	points := []Point{}
+	multiValue := false
+	var last time.Time
+
+	for i, item := range data {
+		pair, isPair := item.([]interface{})
+		if isPair && len(pair) == 2 {
+			if _, nested := pair[1].([]interface{}); i == 0 {
+				multiValue = nested
+			} else if multiValue && !nested {
+				// A bare value of a multi-value meter with two values.
+				isPair = false
+			}
+		} else {
+			isPair = false
+		}
+
+		var values []float64
+		var err error
+		switch {
+		case isPair:
+			offset, ok := pair[0].(float64)
+			if !ok {
+				return nil, fmt.Errorf("invalid monitor data point %d: %v", i, item)
+			}
+			if i == 0 {
+				last = time.Unix(int64(offset), 0)
+			} else {
+				last = last.Add(time.Duration(offset) * time.Second)
+			}
+			values, err = meterValues(pair[1])
+		case i == 0:
+			_, multiValue = item.([]interface{})
+			last = startTime
+			values, err = meterValues(item)
+		default:
+			last = last.Add(step)
+			values, err = meterValues(item)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid monitor data point %d: %v", i, err)
+		}
+		points = append(points, Point{Time: last, Values: values})
+	}
+
+	return points, nil
+}
+
+func meterValues(value interface{}) ([]float64, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		items = []interface{}{value}
+	}
+	values := make([]float64, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case float64:
+			values = append(values, v)
+		case nil, string:
+			values = append(values, math.NaN())
+		default:
+			return nil, fmt.Errorf("invalid value %v", item)
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,88 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package service
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeTestMeter(t *testing.T, content string) *Meter {
+	meter := &Meter{}
+	assert.Nil(t, json.Unmarshal([]byte(content), meter))
+	return meter
+}
+
+func TestDecodeMeterData(t *testing.T) {
+	meter := decodeTestMeter(t, `{"meter_id":"cpu","data":[[1500000000,10],20,[900,30],40]}`)
+	points, err := meter.Points(time.Unix(1400000000, 0), "5m")
+	assert.Nil(t, err)
+	assert.Equal(t, []Point{
+		{Time: time.Unix(1500000000, 0), Values: []float64{10}},
+		{Time: time.Unix(1500000300, 0), Values: []float64{20}},
+		{Time: time.Unix(1500001200, 0), Values: []float64{30}},
+		{Time: time.Unix(1500001500, 0), Values: []float64{40}},
+	}, points)
+}
+
+func TestDecodeMeterDataMultiValue(t *testing.T) {
+	meter := decodeTestMeter(t, `{"meter_id":"disk-os","data":[[1500000000,[1,2]],[3,4],[600,[5,6]],[7,8]]}`)
+	points, err := meter.Points(time.Time{}, "15m")
+	assert.Nil(t, err)
+	assert.Equal(t, []Point{
+		{Time: time.Unix(1500000000, 0), Values: []float64{1, 2}},
+		{Time: time.Unix(1500000900, 0), Values: []float64{3, 4}},
+		{Time: time.Unix(1500001500, 0), Values: []float64{5, 6}},
+		{Time: time.Unix(1500002400, 0), Values: []float64{7, 8}},
+	}, points)
+
+	meter = decodeTestMeter(t, `{"meter_id":"traffic","vxnet_id":"vxnet-1","data":[[1500000000,[1,2,3]],[4,5,6]]}`)
+	points, err = meter.Points(time.Time{}, "2h")
+	assert.Nil(t, err)
+	assert.Equal(t, time.Unix(1500007200, 0), points[1].Time)
+	assert.Equal(t, []float64{4, 5, 6}, points[1].Values)
+}
+
+func TestDecodeMeterDataWithoutTimestamp(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+	points, err := DecodeMeterData([]interface{}{1.0, nil, "NA"}, start, time.Hour*24)
+	assert.Nil(t, err)
+	assert.Len(t, points, 3)
+	assert.Equal(t, start, points[0].Time)
+	assert.Equal(t, start.Add(48*time.Hour), points[2].Time)
+	assert.True(t, math.IsNaN(points[1].Values[0]))
+	assert.True(t, math.IsNaN(points[2].Values[0]))
+}
+
+func TestDecodeMeterDataInvalid(t *testing.T) {
+	_, err := DecodeMeterData([]interface{}{[]interface{}{"x", 1.0}}, time.Time{}, time.Minute)
+	assert.NotNil(t, err)
+
+	_, err = DecodeMeterData([]interface{}{1.0, true}, time.Time{}, time.Minute)
+	assert.NotNil(t, err)
+
+	_, err = (&Meter{}).Points(time.Time{}, "3m")
+	assert.EqualError(t, err, `unknown monitor step "3m"`)
+
+	points, err := DecodeMeterData(nil, time.Time{}, time.Minute)
+	assert.Nil(t, err)
+	assert.Empty(t, points)
+}
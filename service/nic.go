@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type NicService struct {
 	Config     *config.Config
 	Properties *NicServiceProperties
+	Handlers   *request.Handlers
 }
 
 type NicServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Nic(zone string) (*NicService, error) {
 		Zone: &zone,
 	}
 
-	return &NicService{Config: s.Config, Properties: properties}, nil
+	return &NicService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/nic/attach_nics.html
 func (s *NicService) AttachNics(i *AttachNicsInput) (*AttachNicsOutput, error) {
+	return s.AttachNicsWithContext(context.Background(), i)
+}
+
+// AttachNicsWithContext is the same as AttachNics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *NicService) AttachNicsWithContext(ctx context.Context, i *AttachNicsInput) (*AttachNicsOutput, error) {
 	if i == nil {
 		i = &AttachNicsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *NicService) AttachNics(i *AttachNicsInput) (*AttachNicsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +118,12 @@ type AttachNicsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/nic/create_nics.html
 func (s *NicService) CreateNics(i *CreateNicsInput) (*CreateNicsOutput, error) {
+	return s.CreateNicsWithContext(context.Background(), i)
+}
+
+// CreateNicsWithContext is the same as CreateNics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *NicService) CreateNicsWithContext(ctx context.Context, i *CreateNicsInput) (*CreateNicsOutput, error) {
 	if i == nil {
 		i = &CreateNicsInput{}
 	}
@@ -121,8 +139,12 @@ func (s *NicService) CreateNics(i *CreateNicsInput) (*CreateNicsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -159,6 +181,12 @@ type CreateNicsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/nic/delete_nics.html
 func (s *NicService) DeleteNics(i *DeleteNicsInput) (*DeleteNicsOutput, error) {
+	return s.DeleteNicsWithContext(context.Background(), i)
+}
+
+// DeleteNicsWithContext is the same as DeleteNics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *NicService) DeleteNicsWithContext(ctx context.Context, i *DeleteNicsInput) (*DeleteNicsOutput, error) {
 	if i == nil {
 		i = &DeleteNicsInput{}
 	}
@@ -174,8 +202,12 @@ func (s *NicService) DeleteNics(i *DeleteNicsInput) (*DeleteNicsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +239,12 @@ type DeleteNicsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/nic/describe_nics.html
 func (s *NicService) DescribeNics(i *DescribeNicsInput) (*DescribeNicsOutput, error) {
+	return s.DescribeNicsWithContext(context.Background(), i)
+}
+
+// DescribeNicsWithContext is the same as DescribeNics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *NicService) DescribeNicsWithContext(ctx context.Context, i *DescribeNicsInput) (*DescribeNicsOutput, error) {
 	if i == nil {
 		i = &DescribeNicsInput{}
 	}
@@ -222,8 +260,12 @@ func (s *NicService) DescribeNics(i *DescribeNicsInput) (*DescribeNicsOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -278,8 +320,67 @@ type DescribeNicsOutput struct {
 	TotalCount *int    `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeNicsPaginator pages through the results of DescribeNics.
+type DescribeNicsPaginator struct {
+	request.Pagination
+	service *NicService
+	input   DescribeNicsInput
+}
+
+// NewDescribeNicsPaginator create a paginator of DescribeNics starting at
+// the Offset of input.
+func NewDescribeNicsPaginator(s *NicService, i *DescribeNicsInput) *DescribeNicsPaginator {
+	if i == nil {
+		i = &DescribeNicsInput{}
+	}
+	return &DescribeNicsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeNics.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeNicsPaginator) NextPage(ctx context.Context) (*DescribeNicsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeNicsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.NICSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeNicsAll returns the NICSet of all the pages of DescribeNics
+// starting at the Offset of input.
+func (s *NicService) DescribeNicsAll(ctx context.Context, i *DescribeNicsInput) ([]*NIC, error) {
+	p := NewDescribeNicsPaginator(s, i)
+	set := []*NIC{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.NICSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/nic/detach_nics.html
 func (s *NicService) DetachNics(i *DetachNicsInput) (*DetachNicsOutput, error) {
+	return s.DetachNicsWithContext(context.Background(), i)
+}
+
+// DetachNicsWithContext is the same as DetachNics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *NicService) DetachNicsWithContext(ctx context.Context, i *DetachNicsInput) (*DetachNicsOutput, error) {
 	if i == nil {
 		i = &DetachNicsInput{}
 	}
@@ -295,8 +396,12 @@ func (s *NicService) DetachNics(i *DetachNicsInput) (*DetachNicsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +434,12 @@ type DetachNicsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/nic/modify-nic-attributes.html
 func (s *NicService) ModifyNicAttributes(i *ModifyNicAttributesInput) (*ModifyNicAttributesOutput, error) {
+	return s.ModifyNicAttributesWithContext(context.Background(), i)
+}
+
+// ModifyNicAttributesWithContext is the same as ModifyNicAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *NicService) ModifyNicAttributesWithContext(ctx context.Context, i *ModifyNicAttributesInput) (*ModifyNicAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyNicAttributesInput{}
 	}
@@ -344,8 +455,12 @@ func (s *NicService) ModifyNicAttributes(i *ModifyNicAttributesInput) (*ModifyNi
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type NotificationService struct {
 	Config     *config.Config
 	Properties *NotificationServiceProperties
+	Handlers   *request.Handlers
 }
 
 type NotificationServiceProperties struct {
@@ -44,10 +46,16 @@ func (s *QingCloudService) Notification(zone string) (*NotificationService, erro
 		Zone: &zone,
 	}
 
-	return &NotificationService{Config: s.Config, Properties: properties}, nil
+	return &NotificationService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 func (s *NotificationService) DescribeNotificationLists(i *DescribeNotificationListsInput) (*DescribeNotificationListsOutput, error) {
+	return s.DescribeNotificationListsWithContext(context.Background(), i)
+}
+
+// DescribeNotificationListsWithContext is the same as DescribeNotificationLists with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *NotificationService) DescribeNotificationListsWithContext(ctx context.Context, i *DescribeNotificationListsInput) (*DescribeNotificationListsOutput, error) {
 	if i == nil {
 		i = &DescribeNotificationListsInput{}
 	}
@@ -63,8 +71,12 @@ func (s *NotificationService) DescribeNotificationLists(i *DescribeNotificationL
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +111,66 @@ type DescribeNotificationListsOutput struct {
 	TotalCount          *int                `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeNotificationListsPaginator pages through the results of DescribeNotificationLists.
+type DescribeNotificationListsPaginator struct {
+	request.Pagination
+	service *NotificationService
+	input   DescribeNotificationListsInput
+}
+
+// NewDescribeNotificationListsPaginator create a paginator of DescribeNotificationLists starting at
+// the Offset of input.
+func NewDescribeNotificationListsPaginator(s *NotificationService, i *DescribeNotificationListsInput) *DescribeNotificationListsPaginator {
+	if i == nil {
+		i = &DescribeNotificationListsInput{}
+	}
+	return &DescribeNotificationListsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeNotificationLists.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeNotificationListsPaginator) NextPage(ctx context.Context) (*DescribeNotificationListsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeNotificationListsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.NotificationListSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeNotificationListsAll returns the NotificationListSet of all the pages of DescribeNotificationLists
+// starting at the Offset of input.
+func (s *NotificationService) DescribeNotificationListsAll(ctx context.Context, i *DescribeNotificationListsInput) ([]*NotificationList, error) {
+	p := NewDescribeNotificationListsPaginator(s, i)
+	set := []*NotificationList{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.NotificationListSet...)
+	}
+	return set, nil
+}
+
 func (s *NotificationService) SendAlarmNotification(i *SendAlarmNotificationInput) (*SendAlarmNotificationOutput, error) {
+	return s.SendAlarmNotificationWithContext(context.Background(), i)
+}
+
+// SendAlarmNotificationWithContext is the same as SendAlarmNotification with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *NotificationService) SendAlarmNotificationWithContext(ctx context.Context, i *SendAlarmNotificationInput) (*SendAlarmNotificationOutput, error) {
 	if i == nil {
 		i = &SendAlarmNotificationInput{}
 	}
@@ -115,8 +186,12 @@ func (s *NotificationService) SendAlarmNotification(i *SendAlarmNotificationInpu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
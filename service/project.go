@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type ProjectService struct {
 	Config     *config.Config
 	Properties *ProjectServiceProperties
+	Handlers   *request.Handlers
 }
 
 type ProjectServiceProperties struct {
@@ -44,10 +46,16 @@ func (s *QingCloudService) Project(zone string) (*ProjectService, error) {
 		Zone: &zone,
 	}
 
-	return &ProjectService{Config: s.Config, Properties: properties}, nil
+	return &ProjectService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 func (s *ProjectService) AddProjectResourceItems(i *AddProjectResourceItemsInput) (*AddProjectResourceItemsOutput, error) {
+	return s.AddProjectResourceItemsWithContext(context.Background(), i)
+}
+
+// AddProjectResourceItemsWithContext is the same as AddProjectResourceItems with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ProjectService) AddProjectResourceItemsWithContext(ctx context.Context, i *AddProjectResourceItemsInput) (*AddProjectResourceItemsOutput, error) {
 	if i == nil {
 		i = &AddProjectResourceItemsInput{}
 	}
@@ -63,8 +71,12 @@ func (s *ProjectService) AddProjectResourceItems(i *AddProjectResourceItemsInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +118,12 @@ type AddProjectResourceItemsOutput struct {
 }
 
 func (s *ProjectService) DeleteProjectResourceItems(i *DeleteProjectResourceItemsInput) (*DeleteProjectResourceItemsOutput, error) {
+	return s.DeleteProjectResourceItemsWithContext(context.Background(), i)
+}
+
+// DeleteProjectResourceItemsWithContext is the same as DeleteProjectResourceItems with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ProjectService) DeleteProjectResourceItemsWithContext(ctx context.Context, i *DeleteProjectResourceItemsInput) (*DeleteProjectResourceItemsOutput, error) {
 	if i == nil {
 		i = &DeleteProjectResourceItemsInput{}
 	}
@@ -121,8 +139,12 @@ func (s *ProjectService) DeleteProjectResourceItems(i *DeleteProjectResourceItem
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +186,12 @@ type DeleteProjectResourceItemsOutput struct {
 }
 
 func (s *ProjectService) DescribeProjectResourceItems(i *DescribeProjectResourceItemsInput) (*DescribeProjectResourceItemsOutput, error) {
+	return s.DescribeProjectResourceItemsWithContext(context.Background(), i)
+}
+
+// DescribeProjectResourceItemsWithContext is the same as DescribeProjectResourceItems with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ProjectService) DescribeProjectResourceItemsWithContext(ctx context.Context, i *DescribeProjectResourceItemsInput) (*DescribeProjectResourceItemsOutput, error) {
 	if i == nil {
 		i = &DescribeProjectResourceItemsInput{}
 	}
@@ -179,8 +207,12 @@ func (s *ProjectService) DescribeProjectResourceItems(i *DescribeProjectResource
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +246,66 @@ type DescribeProjectResourceItemsOutput struct {
 	TotalCount             *int                   `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeProjectResourceItemsPaginator pages through the results of DescribeProjectResourceItems.
+type DescribeProjectResourceItemsPaginator struct {
+	request.Pagination
+	service *ProjectService
+	input   DescribeProjectResourceItemsInput
+}
+
+// NewDescribeProjectResourceItemsPaginator create a paginator of DescribeProjectResourceItems starting at
+// the Offset of input.
+func NewDescribeProjectResourceItemsPaginator(s *ProjectService, i *DescribeProjectResourceItemsInput) *DescribeProjectResourceItemsPaginator {
+	if i == nil {
+		i = &DescribeProjectResourceItemsInput{}
+	}
+	return &DescribeProjectResourceItemsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeProjectResourceItems.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeProjectResourceItemsPaginator) NextPage(ctx context.Context) (*DescribeProjectResourceItemsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeProjectResourceItemsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.ProjectResourceItemSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeProjectResourceItemsAll returns the ProjectResourceItemSet of all the pages of DescribeProjectResourceItems
+// starting at the Offset of input.
+func (s *ProjectService) DescribeProjectResourceItemsAll(ctx context.Context, i *DescribeProjectResourceItemsInput) ([]*ProjectResourceItem, error) {
+	p := NewDescribeProjectResourceItemsPaginator(s, i)
+	set := []*ProjectResourceItem{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.ProjectResourceItemSet...)
+	}
+	return set, nil
+}
+
 func (s *ProjectService) DescribeProjects(i *DescribeProjectsInput) (*DescribeProjectsOutput, error) {
+	return s.DescribeProjectsWithContext(context.Background(), i)
+}
+
+// DescribeProjectsWithContext is the same as DescribeProjects with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *ProjectService) DescribeProjectsWithContext(ctx context.Context, i *DescribeProjectsInput) (*DescribeProjectsOutput, error) {
 	if i == nil {
 		i = &DescribeProjectsInput{}
 	}
@@ -230,8 +321,12 @@ func (s *ProjectService) DescribeProjects(i *DescribeProjectsInput) (*DescribePr
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -260,3 +355,56 @@ type DescribeProjectsOutput struct {
 	RetCode    *int       `json:"ret_code" name:"ret_code" location:"elements"`
 	TotalCount *int       `json:"total_count" name:"total_count" location:"elements"`
 }
+
+// DescribeProjectsPaginator pages through the results of DescribeProjects.
+type DescribeProjectsPaginator struct {
+	request.Pagination
+	service *ProjectService
+	input   DescribeProjectsInput
+}
+
+// NewDescribeProjectsPaginator create a paginator of DescribeProjects starting at
+// the Offset of input.
+func NewDescribeProjectsPaginator(s *ProjectService, i *DescribeProjectsInput) *DescribeProjectsPaginator {
+	if i == nil {
+		i = &DescribeProjectsInput{}
+	}
+	return &DescribeProjectsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeProjects.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeProjectsPaginator) NextPage(ctx context.Context) (*DescribeProjectsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeProjectsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.ProjectSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeProjectsAll returns the ProjectSet of all the pages of DescribeProjects
+// starting at the Offset of input.
+func (s *ProjectService) DescribeProjectsAll(ctx context.Context, i *DescribeProjectsInput) ([]*Project, error) {
+	p := NewDescribeProjectsPaginator(s, i)
+	set := []*Project{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.ProjectSet...)
+	}
+	return set, nil
+}
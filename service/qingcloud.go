@@ -18,6 +18,7 @@
 package service
 
 import (
+	"context"
 	"github.com/yunify/qingcloud-sdk-go/config"
 	"github.com/yunify/qingcloud-sdk-go/logger"
 	"github.com/yunify/qingcloud-sdk-go/request"
@@ -28,6 +29,7 @@ import (
 type QingCloudService struct {
 	Config     *config.Config
 	Properties *QingCloudServiceProperties
+	Handlers   *request.Handlers
 }
 
 type QingCloudServiceProperties struct {
@@ -35,12 +37,23 @@ type QingCloudServiceProperties struct {
 
 func Init(c *config.Config) (*QingCloudService, error) {
 	properties := &QingCloudServiceProperties{}
-	logger.SetLevel(c.LogLevel)
-	return &QingCloudService{Config: c, Properties: properties}, nil
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := logger.SetLevel(c.LogLevel); err != nil {
+		return nil, err
+	}
+	return &QingCloudService{Config: c, Properties: properties, Handlers: request.DefaultHandlers()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/zone/describe_zones.html
 func (s *QingCloudService) DescribeZones(i *DescribeZonesInput) (*DescribeZonesOutput, error) {
+	return s.DescribeZonesWithContext(context.Background(), i)
+}
+
+// DescribeZonesWithContext is the same as DescribeZones with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *QingCloudService) DescribeZonesWithContext(ctx context.Context, i *DescribeZonesInput) (*DescribeZonesOutput, error) {
 	if i == nil {
 		i = &DescribeZonesInput{}
 	}
@@ -56,8 +69,12 @@ func (s *QingCloudService) DescribeZones(i *DescribeZonesInput) (*DescribeZonesO
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,133 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/config"
+	"github.com/yunify/qingcloud-sdk-go/logger"
+	"github.com/yunify/qingcloud-sdk-go/request"
+)
+
+func newTestService(t *testing.T, server *httptest.Server) (*config.Config, *QingCloudService) {
+	c, err := config.New("AccessKeyID", "SecretAccessKey")
+	assert.Nil(t, err)
+	u, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	c.Protocol = u.Scheme
+	c.Host = u.Hostname()
+	c.Port, _ = strconv.Atoi(u.Port())
+	s, err := Init(c)
+	assert.Nil(t, err)
+	return c, s
+}
+
+func TestInit(t *testing.T) {
+	c, err := config.New("AccessKeyID", "SecretAccessKey")
+	assert.Nil(t, err)
+	s, err := Init(c)
+	assert.Nil(t, err)
+	assert.NotNil(t, s)
+
+	level := logger.GetLevel()
+	_, err = Init(&config.Config{Host: "api.qingcloud.com", Protocol: "https", Port: 443, LogLevel: "verbose"})
+	assert.NotNil(t, err)
+	assert.Equal(t, level, logger.GetLevel())
+	assert.NotNil(t, logger.SetLevel("verbose"))
+}
+
+func TestConfigHandlers(t *testing.T) {
+	traces := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traces = append(traces, r.Header.Get("X-Trace"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"ret_code":0,"action":"DescribeInstancesResponse","total_count":0,"instance_set":[]}`)
+	}))
+	defer server.Close()
+
+	c, s := newTestService(t, server)
+	instanceService, err := s.Instance("pek3a")
+	assert.Nil(t, err)
+
+	// Handlers set after the services are created still run for them.
+	handled := []string{}
+	h := &request.Handlers{}
+	h.Build.PushBack(func(r *request.Request) error {
+		r.HTTPRequest.Header.Set("X-Trace", "trace-1")
+		return nil
+	})
+	h.Complete.PushBack(func(r *request.Request) error {
+		handled = append(handled, r.Operation.APIName)
+		return nil
+	})
+	c.Handlers = h
+	assert.Equal(t, h, request.ConfigHandlers(c))
+
+	output, err := instanceService.DescribeInstances(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, IntValue(output.RetCode))
+	assert.Equal(t, []string{"trace-1"}, traces)
+	assert.Equal(t, []string{"DescribeInstances"}, handled)
+	assert.Equal(t, 0, instanceService.Handlers.Complete.Len())
+}
+
+func TestWaitUntilVolumesStatus(t *testing.T) {
+	ids := []*string{}
+	for i := 0; i < 45; i++ {
+		ids = append(ids, String(fmt.Sprintf("vol-%d", i)))
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Nil(t, r.ParseForm())
+		offset, _ := strconv.Atoi(r.Form.Get("offset"))
+		limit, err := strconv.Atoi(r.Form.Get("limit"))
+		if err != nil {
+			limit = request.DefaultPageLimit
+		}
+		volumes := []string{}
+		for i := offset; i < offset+limit && i < len(ids); i++ {
+			volumes = append(volumes, fmt.Sprintf(
+				`{"volume_id":"%s","status":"available","transition_status":""}`, *ids[i]))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"ret_code":0,"action":"DescribeVolumesResponse","total_count":%d,"volume_set":[%s]}`,
+			len(ids), strings.Join(volumes, ","))
+	}))
+	defer server.Close()
+
+	_, s := newTestService(t, server)
+	volumeService, err := s.Volume("pek3a")
+	assert.Nil(t, err)
+
+	volumes, err := volumeService.WaitUntilVolumesStatus(context.Background(), ids, "available")
+	assert.Nil(t, err)
+	assert.Len(t, volumes, len(ids))
+	assert.Equal(t, "vol-44", StringValue(volumes[44].VolumeID))
+	assert.Equal(t, 3, requests)
+}
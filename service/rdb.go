@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type RDBService struct {
 	Config     *config.Config
 	Properties *RDBServiceProperties
+	Handlers   *request.Handlers
 }
 
 type RDBServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) RDB(zone string) (*RDBService, error) {
 		Zone: &zone,
 	}
 
-	return &RDBService{Config: s.Config, Properties: properties}, nil
+	return &RDBService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/apply_rdb_parameter_group.html
 func (s *RDBService) ApplyRDBParameterGroup(i *ApplyRDBParameterGroupInput) (*ApplyRDBParameterGroupOutput, error) {
+	return s.ApplyRDBParameterGroupWithContext(context.Background(), i)
+}
+
+// ApplyRDBParameterGroupWithContext is the same as ApplyRDBParameterGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) ApplyRDBParameterGroupWithContext(ctx context.Context, i *ApplyRDBParameterGroupInput) (*ApplyRDBParameterGroupOutput, error) {
 	if i == nil {
 		i = &ApplyRDBParameterGroupInput{}
 	}
@@ -64,8 +72,12 @@ func (s *RDBService) ApplyRDBParameterGroup(i *ApplyRDBParameterGroupInput) (*Ap
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +111,12 @@ type ApplyRDBParameterGroupOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/cease_rdb_instance.html
 func (s *RDBService) CeaseRDBInstance(i *CeaseRDBInstanceInput) (*CeaseRDBInstanceOutput, error) {
+	return s.CeaseRDBInstanceWithContext(context.Background(), i)
+}
+
+// CeaseRDBInstanceWithContext is the same as CeaseRDBInstance with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) CeaseRDBInstanceWithContext(ctx context.Context, i *CeaseRDBInstanceInput) (*CeaseRDBInstanceOutput, error) {
 	if i == nil {
 		i = &CeaseRDBInstanceInput{}
 	}
@@ -114,8 +132,12 @@ func (s *RDBService) CeaseRDBInstance(i *CeaseRDBInstanceInput) (*CeaseRDBInstan
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +178,12 @@ type CeaseRDBInstanceOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/copy_rdb_instance_files_to_ftp.html
 func (s *RDBService) CopyRDBInstanceFilesToFTP(i *CopyRDBInstanceFilesToFTPInput) (*CopyRDBInstanceFilesToFTPOutput, error) {
+	return s.CopyRDBInstanceFilesToFTPWithContext(context.Background(), i)
+}
+
+// CopyRDBInstanceFilesToFTPWithContext is the same as CopyRDBInstanceFilesToFTP with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) CopyRDBInstanceFilesToFTPWithContext(ctx context.Context, i *CopyRDBInstanceFilesToFTPInput) (*CopyRDBInstanceFilesToFTPOutput, error) {
 	if i == nil {
 		i = &CopyRDBInstanceFilesToFTPInput{}
 	}
@@ -171,8 +199,12 @@ func (s *RDBService) CopyRDBInstanceFilesToFTP(i *CopyRDBInstanceFilesToFTPInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +246,12 @@ type CopyRDBInstanceFilesToFTPOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/create_rdb.html
 func (s *RDBService) CreateRDB(i *CreateRDBInput) (*CreateRDBOutput, error) {
+	return s.CreateRDBWithContext(context.Background(), i)
+}
+
+// CreateRDBWithContext is the same as CreateRDB with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) CreateRDBWithContext(ctx context.Context, i *CreateRDBInput) (*CreateRDBOutput, error) {
 	if i == nil {
 		i = &CreateRDBInput{}
 	}
@@ -229,8 +267,12 @@ func (s *RDBService) CreateRDB(i *CreateRDBInput) (*CreateRDBOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -376,6 +418,12 @@ type CreateRDBOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/create_rdb_from_snapshot.html
 func (s *RDBService) CreateRDBFromSnapshot(i *CreateRDBFromSnapshotInput) (*CreateRDBFromSnapshotOutput, error) {
+	return s.CreateRDBFromSnapshotWithContext(context.Background(), i)
+}
+
+// CreateRDBFromSnapshotWithContext is the same as CreateRDBFromSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) CreateRDBFromSnapshotWithContext(ctx context.Context, i *CreateRDBFromSnapshotInput) (*CreateRDBFromSnapshotOutput, error) {
 	if i == nil {
 		i = &CreateRDBFromSnapshotInput{}
 	}
@@ -391,8 +439,12 @@ func (s *RDBService) CreateRDBFromSnapshot(i *CreateRDBFromSnapshotInput) (*Crea
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -522,6 +574,12 @@ type CreateRDBFromSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/create_temp_rdb_instance_from_snapshot.html
 func (s *RDBService) CreateTempRDBInstanceFromSnapshot(i *CreateTempRDBInstanceFromSnapshotInput) (*CreateTempRDBInstanceFromSnapshotOutput, error) {
+	return s.CreateTempRDBInstanceFromSnapshotWithContext(context.Background(), i)
+}
+
+// CreateTempRDBInstanceFromSnapshotWithContext is the same as CreateTempRDBInstanceFromSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) CreateTempRDBInstanceFromSnapshotWithContext(ctx context.Context, i *CreateTempRDBInstanceFromSnapshotInput) (*CreateTempRDBInstanceFromSnapshotOutput, error) {
 	if i == nil {
 		i = &CreateTempRDBInstanceFromSnapshotInput{}
 	}
@@ -537,8 +595,12 @@ func (s *RDBService) CreateTempRDBInstanceFromSnapshot(i *CreateTempRDBInstanceF
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -580,6 +642,12 @@ type CreateTempRDBInstanceFromSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/delete_rdbs.html
 func (s *RDBService) DeleteRDBs(i *DeleteRDBsInput) (*DeleteRDBsOutput, error) {
+	return s.DeleteRDBsWithContext(context.Background(), i)
+}
+
+// DeleteRDBsWithContext is the same as DeleteRDBs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) DeleteRDBsWithContext(ctx context.Context, i *DeleteRDBsInput) (*DeleteRDBsOutput, error) {
 	if i == nil {
 		i = &DeleteRDBsInput{}
 	}
@@ -595,8 +663,12 @@ func (s *RDBService) DeleteRDBs(i *DeleteRDBsInput) (*DeleteRDBsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -630,6 +702,12 @@ type DeleteRDBsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/describe_rdb_parameters.html
 func (s *RDBService) DescribeRDBParameters(i *DescribeRDBParametersInput) (*DescribeRDBParametersOutput, error) {
+	return s.DescribeRDBParametersWithContext(context.Background(), i)
+}
+
+// DescribeRDBParametersWithContext is the same as DescribeRDBParameters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) DescribeRDBParametersWithContext(ctx context.Context, i *DescribeRDBParametersInput) (*DescribeRDBParametersOutput, error) {
 	if i == nil {
 		i = &DescribeRDBParametersInput{}
 	}
@@ -645,8 +723,12 @@ func (s *RDBService) DescribeRDBParameters(i *DescribeRDBParametersInput) (*Desc
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -681,8 +763,67 @@ type DescribeRDBParametersOutput struct {
 	TotalCount   *int            `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeRDBParametersPaginator pages through the results of DescribeRDBParameters.
+type DescribeRDBParametersPaginator struct {
+	request.Pagination
+	service *RDBService
+	input   DescribeRDBParametersInput
+}
+
+// NewDescribeRDBParametersPaginator create a paginator of DescribeRDBParameters starting at
+// the Offset of input.
+func NewDescribeRDBParametersPaginator(s *RDBService, i *DescribeRDBParametersInput) *DescribeRDBParametersPaginator {
+	if i == nil {
+		i = &DescribeRDBParametersInput{}
+	}
+	return &DescribeRDBParametersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeRDBParameters.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeRDBParametersPaginator) NextPage(ctx context.Context) (*DescribeRDBParametersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeRDBParametersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.ParameterSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeRDBParametersAll returns the ParameterSet of all the pages of DescribeRDBParameters
+// starting at the Offset of input.
+func (s *RDBService) DescribeRDBParametersAll(ctx context.Context, i *DescribeRDBParametersInput) ([]*RDBParameter, error) {
+	p := NewDescribeRDBParametersPaginator(s, i)
+	set := []*RDBParameter{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.ParameterSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/rdb/describe_rdbs.html
 func (s *RDBService) DescribeRDBs(i *DescribeRDBsInput) (*DescribeRDBsOutput, error) {
+	return s.DescribeRDBsWithContext(context.Background(), i)
+}
+
+// DescribeRDBsWithContext is the same as DescribeRDBs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) DescribeRDBsWithContext(ctx context.Context, i *DescribeRDBsInput) (*DescribeRDBsOutput, error) {
 	if i == nil {
 		i = &DescribeRDBsInput{}
 	}
@@ -698,8 +839,12 @@ func (s *RDBService) DescribeRDBs(i *DescribeRDBsInput) (*DescribeRDBsOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -733,8 +878,99 @@ type DescribeRDBsOutput struct {
 	TotalCount *int    `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeRDBsPaginator pages through the results of DescribeRDBs.
+type DescribeRDBsPaginator struct {
+	request.Pagination
+	service *RDBService
+	input   DescribeRDBsInput
+}
+
+// NewDescribeRDBsPaginator create a paginator of DescribeRDBs starting at
+// the Offset of input.
+func NewDescribeRDBsPaginator(s *RDBService, i *DescribeRDBsInput) *DescribeRDBsPaginator {
+	if i == nil {
+		i = &DescribeRDBsInput{}
+	}
+	return &DescribeRDBsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeRDBs.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeRDBsPaginator) NextPage(ctx context.Context) (*DescribeRDBsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeRDBsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.RDBSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeRDBsAll returns the RDBSet of all the pages of DescribeRDBs
+// starting at the Offset of input.
+func (s *RDBService) DescribeRDBsAll(ctx context.Context, i *DescribeRDBsInput) ([]*RDB, error) {
+	p := NewDescribeRDBsPaginator(s, i)
+	set := []*RDB{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.RDBSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilRDBsStatus waits until all the given RDBs are in the
+// status without transition status, it fails if any of them is not found.
+func (s *RDBService) WaitUntilRDBsStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*RDB, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilRDBsStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeRDBsAll(ctx, &DescribeRDBsInput{
+				RDBs: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeRDBsOutput{RDBSet: set}, nil
+		},
+		StatusPath:           "RDBSet[].Status",
+		TransitionStatusPath: "RDBSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeRDBsOutput).RDBSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/rdb/get_rdb_instance_files.html
 func (s *RDBService) GetRDBInstanceFiles(i *GetRDBInstanceFilesInput) (*GetRDBInstanceFilesOutput, error) {
+	return s.GetRDBInstanceFilesWithContext(context.Background(), i)
+}
+
+// GetRDBInstanceFilesWithContext is the same as GetRDBInstanceFiles with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) GetRDBInstanceFilesWithContext(ctx context.Context, i *GetRDBInstanceFilesInput) (*GetRDBInstanceFilesOutput, error) {
 	if i == nil {
 		i = &GetRDBInstanceFilesInput{}
 	}
@@ -750,8 +986,12 @@ func (s *RDBService) GetRDBInstanceFiles(i *GetRDBInstanceFilesInput) (*GetRDBIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -785,6 +1025,12 @@ type GetRDBInstanceFilesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/monitor/get_rdb_monitor.html
 func (s *RDBService) GetRDBMonitor(i *GetRDBMonitorInput) (*GetRDBMonitorOutput, error) {
+	return s.GetRDBMonitorWithContext(context.Background(), i)
+}
+
+// GetRDBMonitorWithContext is the same as GetRDBMonitor with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) GetRDBMonitorWithContext(ctx context.Context, i *GetRDBMonitorInput) (*GetRDBMonitorOutput, error) {
 	if i == nil {
 		i = &GetRDBMonitorInput{}
 	}
@@ -800,8 +1046,12 @@ func (s *RDBService) GetRDBMonitor(i *GetRDBMonitorInput) (*GetRDBMonitorOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -891,6 +1141,12 @@ type GetRDBMonitorOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/modify_rdb_parameters.html
 func (s *RDBService) ModifyRDBParameters(i *ModifyRDBParametersInput) (*ModifyRDBParametersOutput, error) {
+	return s.ModifyRDBParametersWithContext(context.Background(), i)
+}
+
+// ModifyRDBParametersWithContext is the same as ModifyRDBParameters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) ModifyRDBParametersWithContext(ctx context.Context, i *ModifyRDBParametersInput) (*ModifyRDBParametersOutput, error) {
 	if i == nil {
 		i = &ModifyRDBParametersInput{}
 	}
@@ -906,8 +1162,12 @@ func (s *RDBService) ModifyRDBParameters(i *ModifyRDBParametersInput) (*ModifyRD
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -949,6 +1209,12 @@ type ModifyRDBParametersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/rdbs_join_vxnet.html
 func (s *RDBService) RDBsJoinVxNet(i *RDBsJoinVxNetInput) (*RDBsJoinVxNetOutput, error) {
+	return s.RDBsJoinVxNetWithContext(context.Background(), i)
+}
+
+// RDBsJoinVxNetWithContext is the same as RDBsJoinVxNet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) RDBsJoinVxNetWithContext(ctx context.Context, i *RDBsJoinVxNetInput) (*RDBsJoinVxNetOutput, error) {
 	if i == nil {
 		i = &RDBsJoinVxNetInput{}
 	}
@@ -964,8 +1230,12 @@ func (s *RDBService) RDBsJoinVxNet(i *RDBsJoinVxNetInput) (*RDBsJoinVxNetOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,6 +1278,12 @@ type RDBsJoinVxNetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/rdbs_leave_vxnet.html
 func (s *RDBService) RDBsLeaveVxNet(i *RDBsLeaveVxNetInput) (*RDBsLeaveVxNetOutput, error) {
+	return s.RDBsLeaveVxNetWithContext(context.Background(), i)
+}
+
+// RDBsLeaveVxNetWithContext is the same as RDBsLeaveVxNet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) RDBsLeaveVxNetWithContext(ctx context.Context, i *RDBsLeaveVxNetInput) (*RDBsLeaveVxNetOutput, error) {
 	if i == nil {
 		i = &RDBsLeaveVxNetInput{}
 	}
@@ -1023,8 +1299,12 @@ func (s *RDBService) RDBsLeaveVxNet(i *RDBsLeaveVxNetInput) (*RDBsLeaveVxNetOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1065,6 +1345,12 @@ type RDBsLeaveVxNetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/resize_rdbs.html
 func (s *RDBService) ResizeRDBs(i *ResizeRDBsInput) (*ResizeRDBsOutput, error) {
+	return s.ResizeRDBsWithContext(context.Background(), i)
+}
+
+// ResizeRDBsWithContext is the same as ResizeRDBs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) ResizeRDBsWithContext(ctx context.Context, i *ResizeRDBsInput) (*ResizeRDBsOutput, error) {
 	if i == nil {
 		i = &ResizeRDBsInput{}
 	}
@@ -1080,8 +1366,12 @@ func (s *RDBService) ResizeRDBs(i *ResizeRDBsInput) (*ResizeRDBsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1139,6 +1429,12 @@ type ResizeRDBsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/start_rdbs.html
 func (s *RDBService) StartRDBs(i *StartRDBsInput) (*StartRDBsOutput, error) {
+	return s.StartRDBsWithContext(context.Background(), i)
+}
+
+// StartRDBsWithContext is the same as StartRDBs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) StartRDBsWithContext(ctx context.Context, i *StartRDBsInput) (*StartRDBsOutput, error) {
 	if i == nil {
 		i = &StartRDBsInput{}
 	}
@@ -1154,8 +1450,12 @@ func (s *RDBService) StartRDBs(i *StartRDBsInput) (*StartRDBsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1189,6 +1489,12 @@ type StartRDBsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/rdb/stop_rdbs.html
 func (s *RDBService) StopRDBs(i *StopRDBsInput) (*StopRDBsOutput, error) {
+	return s.StopRDBsWithContext(context.Background(), i)
+}
+
+// StopRDBsWithContext is the same as StopRDBs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RDBService) StopRDBsWithContext(ctx context.Context, i *StopRDBsInput) (*StopRDBsOutput, error) {
 	if i == nil {
 		i = &StopRDBsInput{}
 	}
@@ -1204,8 +1510,12 @@ func (s *RDBService) StopRDBs(i *StopRDBsInput) (*StopRDBsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type RouterService struct {
 	Config     *config.Config
 	Properties *RouterServiceProperties
+	Handlers   *request.Handlers
 }
 
 type RouterServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Router(zone string) (*RouterService, error) {
 		Zone: &zone,
 	}
 
-	return &RouterService{Config: s.Config, Properties: properties}, nil
+	return &RouterService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/router/add_router_static_entries.html
 func (s *RouterService) AddRouterStaticEntries(i *AddRouterStaticEntriesInput) (*AddRouterStaticEntriesOutput, error) {
+	return s.AddRouterStaticEntriesWithContext(context.Background(), i)
+}
+
+// AddRouterStaticEntriesWithContext is the same as AddRouterStaticEntries with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) AddRouterStaticEntriesWithContext(ctx context.Context, i *AddRouterStaticEntriesInput) (*AddRouterStaticEntriesOutput, error) {
 	if i == nil {
 		i = &AddRouterStaticEntriesInput{}
 	}
@@ -64,8 +72,12 @@ func (s *RouterService) AddRouterStaticEntries(i *AddRouterStaticEntriesInput) (
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +119,12 @@ type AddRouterStaticEntriesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/add_router_statics.html
 func (s *RouterService) AddRouterStatics(i *AddRouterStaticsInput) (*AddRouterStaticsOutput, error) {
+	return s.AddRouterStaticsWithContext(context.Background(), i)
+}
+
+// AddRouterStaticsWithContext is the same as AddRouterStatics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) AddRouterStaticsWithContext(ctx context.Context, i *AddRouterStaticsInput) (*AddRouterStaticsOutput, error) {
 	if i == nil {
 		i = &AddRouterStaticsInput{}
 	}
@@ -122,8 +140,12 @@ func (s *RouterService) AddRouterStatics(i *AddRouterStaticsInput) (*AddRouterSt
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +195,12 @@ type AddRouterStaticsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/create_routers.html
 func (s *RouterService) CreateRouters(i *CreateRoutersInput) (*CreateRoutersOutput, error) {
+	return s.CreateRoutersWithContext(context.Background(), i)
+}
+
+// CreateRoutersWithContext is the same as CreateRouters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) CreateRoutersWithContext(ctx context.Context, i *CreateRoutersInput) (*CreateRoutersOutput, error) {
 	if i == nil {
 		i = &CreateRoutersInput{}
 	}
@@ -188,8 +216,12 @@ func (s *RouterService) CreateRouters(i *CreateRoutersInput) (*CreateRoutersOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -241,6 +273,12 @@ type CreateRoutersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/delete_router_static_entries.html
 func (s *RouterService) DeleteRouterStaticEntries(i *DeleteRouterStaticEntriesInput) (*DeleteRouterStaticEntriesOutput, error) {
+	return s.DeleteRouterStaticEntriesWithContext(context.Background(), i)
+}
+
+// DeleteRouterStaticEntriesWithContext is the same as DeleteRouterStaticEntries with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) DeleteRouterStaticEntriesWithContext(ctx context.Context, i *DeleteRouterStaticEntriesInput) (*DeleteRouterStaticEntriesOutput, error) {
 	if i == nil {
 		i = &DeleteRouterStaticEntriesInput{}
 	}
@@ -256,8 +294,12 @@ func (s *RouterService) DeleteRouterStaticEntries(i *DeleteRouterStaticEntriesIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -290,6 +332,12 @@ type DeleteRouterStaticEntriesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/delete_router_statics.html
 func (s *RouterService) DeleteRouterStatics(i *DeleteRouterStaticsInput) (*DeleteRouterStaticsOutput, error) {
+	return s.DeleteRouterStaticsWithContext(context.Background(), i)
+}
+
+// DeleteRouterStaticsWithContext is the same as DeleteRouterStatics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) DeleteRouterStaticsWithContext(ctx context.Context, i *DeleteRouterStaticsInput) (*DeleteRouterStaticsOutput, error) {
 	if i == nil {
 		i = &DeleteRouterStaticsInput{}
 	}
@@ -305,8 +353,12 @@ func (s *RouterService) DeleteRouterStatics(i *DeleteRouterStaticsInput) (*Delet
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +391,12 @@ type DeleteRouterStaticsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/delete_routers.html
 func (s *RouterService) DeleteRouters(i *DeleteRoutersInput) (*DeleteRoutersOutput, error) {
+	return s.DeleteRoutersWithContext(context.Background(), i)
+}
+
+// DeleteRoutersWithContext is the same as DeleteRouters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) DeleteRoutersWithContext(ctx context.Context, i *DeleteRoutersInput) (*DeleteRoutersOutput, error) {
 	if i == nil {
 		i = &DeleteRoutersInput{}
 	}
@@ -354,8 +412,12 @@ func (s *RouterService) DeleteRouters(i *DeleteRoutersInput) (*DeleteRoutersOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -389,6 +451,12 @@ type DeleteRoutersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/describe_router_static_entries.html
 func (s *RouterService) DescribeRouterStaticEntries(i *DescribeRouterStaticEntriesInput) (*DescribeRouterStaticEntriesOutput, error) {
+	return s.DescribeRouterStaticEntriesWithContext(context.Background(), i)
+}
+
+// DescribeRouterStaticEntriesWithContext is the same as DescribeRouterStaticEntries with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) DescribeRouterStaticEntriesWithContext(ctx context.Context, i *DescribeRouterStaticEntriesInput) (*DescribeRouterStaticEntriesOutput, error) {
 	if i == nil {
 		i = &DescribeRouterStaticEntriesInput{}
 	}
@@ -404,8 +472,12 @@ func (s *RouterService) DescribeRouterStaticEntries(i *DescribeRouterStaticEntri
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -434,8 +506,67 @@ type DescribeRouterStaticEntriesOutput struct {
 	TotalCount           *int                 `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeRouterStaticEntriesPaginator pages through the results of DescribeRouterStaticEntries.
+type DescribeRouterStaticEntriesPaginator struct {
+	request.Pagination
+	service *RouterService
+	input   DescribeRouterStaticEntriesInput
+}
+
+// NewDescribeRouterStaticEntriesPaginator create a paginator of DescribeRouterStaticEntries starting at
+// the Offset of input.
+func NewDescribeRouterStaticEntriesPaginator(s *RouterService, i *DescribeRouterStaticEntriesInput) *DescribeRouterStaticEntriesPaginator {
+	if i == nil {
+		i = &DescribeRouterStaticEntriesInput{}
+	}
+	return &DescribeRouterStaticEntriesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeRouterStaticEntries.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeRouterStaticEntriesPaginator) NextPage(ctx context.Context) (*DescribeRouterStaticEntriesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeRouterStaticEntriesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.RouterStaticEntrySet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeRouterStaticEntriesAll returns the RouterStaticEntrySet of all the pages of DescribeRouterStaticEntries
+// starting at the Offset of input.
+func (s *RouterService) DescribeRouterStaticEntriesAll(ctx context.Context, i *DescribeRouterStaticEntriesInput) ([]*RouterStaticEntry, error) {
+	p := NewDescribeRouterStaticEntriesPaginator(s, i)
+	set := []*RouterStaticEntry{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.RouterStaticEntrySet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/router/describe_router_statics.html
 func (s *RouterService) DescribeRouterStatics(i *DescribeRouterStaticsInput) (*DescribeRouterStaticsOutput, error) {
+	return s.DescribeRouterStaticsWithContext(context.Background(), i)
+}
+
+// DescribeRouterStaticsWithContext is the same as DescribeRouterStatics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) DescribeRouterStaticsWithContext(ctx context.Context, i *DescribeRouterStaticsInput) (*DescribeRouterStaticsOutput, error) {
 	if i == nil {
 		i = &DescribeRouterStaticsInput{}
 	}
@@ -451,8 +582,12 @@ func (s *RouterService) DescribeRouterStatics(i *DescribeRouterStaticsInput) (*D
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -533,8 +668,67 @@ type DescribeRouterStaticsOutput struct {
 	TotalCount      *int            `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeRouterStaticsPaginator pages through the results of DescribeRouterStatics.
+type DescribeRouterStaticsPaginator struct {
+	request.Pagination
+	service *RouterService
+	input   DescribeRouterStaticsInput
+}
+
+// NewDescribeRouterStaticsPaginator create a paginator of DescribeRouterStatics starting at
+// the Offset of input.
+func NewDescribeRouterStaticsPaginator(s *RouterService, i *DescribeRouterStaticsInput) *DescribeRouterStaticsPaginator {
+	if i == nil {
+		i = &DescribeRouterStaticsInput{}
+	}
+	return &DescribeRouterStaticsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeRouterStatics.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeRouterStaticsPaginator) NextPage(ctx context.Context) (*DescribeRouterStaticsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeRouterStaticsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.RouterStaticSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeRouterStaticsAll returns the RouterStaticSet of all the pages of DescribeRouterStatics
+// starting at the Offset of input.
+func (s *RouterService) DescribeRouterStaticsAll(ctx context.Context, i *DescribeRouterStaticsInput) ([]*RouterStatic, error) {
+	p := NewDescribeRouterStaticsPaginator(s, i)
+	set := []*RouterStatic{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.RouterStaticSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/router/describe_router_vxnets.html
 func (s *RouterService) DescribeRouterVxNets(i *DescribeRouterVxNetsInput) (*DescribeRouterVxNetsOutput, error) {
+	return s.DescribeRouterVxNetsWithContext(context.Background(), i)
+}
+
+// DescribeRouterVxNetsWithContext is the same as DescribeRouterVxNets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) DescribeRouterVxNetsWithContext(ctx context.Context, i *DescribeRouterVxNetsInput) (*DescribeRouterVxNetsOutput, error) {
 	if i == nil {
 		i = &DescribeRouterVxNetsInput{}
 	}
@@ -550,8 +744,12 @@ func (s *RouterService) DescribeRouterVxNets(i *DescribeRouterVxNetsInput) (*Des
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -608,8 +806,67 @@ type DescribeRouterVxNetsOutput struct {
 	TotalCount     *int           `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeRouterVxNetsPaginator pages through the results of DescribeRouterVxNets.
+type DescribeRouterVxNetsPaginator struct {
+	request.Pagination
+	service *RouterService
+	input   DescribeRouterVxNetsInput
+}
+
+// NewDescribeRouterVxNetsPaginator create a paginator of DescribeRouterVxNets starting at
+// the Offset of input.
+func NewDescribeRouterVxNetsPaginator(s *RouterService, i *DescribeRouterVxNetsInput) *DescribeRouterVxNetsPaginator {
+	if i == nil {
+		i = &DescribeRouterVxNetsInput{}
+	}
+	return &DescribeRouterVxNetsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeRouterVxNets.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeRouterVxNetsPaginator) NextPage(ctx context.Context) (*DescribeRouterVxNetsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeRouterVxNetsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.RouterVxNetSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeRouterVxNetsAll returns the RouterVxNetSet of all the pages of DescribeRouterVxNets
+// starting at the Offset of input.
+func (s *RouterService) DescribeRouterVxNetsAll(ctx context.Context, i *DescribeRouterVxNetsInput) ([]*RouterVxNet, error) {
+	p := NewDescribeRouterVxNetsPaginator(s, i)
+	set := []*RouterVxNet{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.RouterVxNetSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/router/describe_routers.html
 func (s *RouterService) DescribeRouters(i *DescribeRoutersInput) (*DescribeRoutersOutput, error) {
+	return s.DescribeRoutersWithContext(context.Background(), i)
+}
+
+// DescribeRoutersWithContext is the same as DescribeRouters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) DescribeRoutersWithContext(ctx context.Context, i *DescribeRoutersInput) (*DescribeRoutersOutput, error) {
 	if i == nil {
 		i = &DescribeRoutersInput{}
 	}
@@ -625,8 +882,12 @@ func (s *RouterService) DescribeRouters(i *DescribeRoutersInput) (*DescribeRoute
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -681,8 +942,99 @@ type DescribeRoutersOutput struct {
 	TotalCount *int      `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeRoutersPaginator pages through the results of DescribeRouters.
+type DescribeRoutersPaginator struct {
+	request.Pagination
+	service *RouterService
+	input   DescribeRoutersInput
+}
+
+// NewDescribeRoutersPaginator create a paginator of DescribeRouters starting at
+// the Offset of input.
+func NewDescribeRoutersPaginator(s *RouterService, i *DescribeRoutersInput) *DescribeRoutersPaginator {
+	if i == nil {
+		i = &DescribeRoutersInput{}
+	}
+	return &DescribeRoutersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeRouters.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeRoutersPaginator) NextPage(ctx context.Context) (*DescribeRoutersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeRoutersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.RouterSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeRoutersAll returns the RouterSet of all the pages of DescribeRouters
+// starting at the Offset of input.
+func (s *RouterService) DescribeRoutersAll(ctx context.Context, i *DescribeRoutersInput) ([]*Router, error) {
+	p := NewDescribeRoutersPaginator(s, i)
+	set := []*Router{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.RouterSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilRoutersStatus waits until all the given Routers are in the
+// status without transition status, it fails if any of them is not found.
+func (s *RouterService) WaitUntilRoutersStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*Router, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilRoutersStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeRoutersAll(ctx, &DescribeRoutersInput{
+				Routers: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeRoutersOutput{RouterSet: set}, nil
+		},
+		StatusPath:           "RouterSet[].Status",
+		TransitionStatusPath: "RouterSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeRoutersOutput).RouterSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/monitor/get_monitor.html
 func (s *RouterService) GetRouterMonitor(i *GetRouterMonitorInput) (*GetRouterMonitorOutput, error) {
+	return s.GetRouterMonitorWithContext(context.Background(), i)
+}
+
+// GetRouterMonitorWithContext is the same as GetRouterMonitor with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) GetRouterMonitorWithContext(ctx context.Context, i *GetRouterMonitorInput) (*GetRouterMonitorOutput, error) {
 	if i == nil {
 		i = &GetRouterMonitorInput{}
 	}
@@ -698,8 +1050,12 @@ func (s *RouterService) GetRouterMonitor(i *GetRouterMonitorInput) (*GetRouterMo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -772,6 +1128,12 @@ type GetRouterMonitorOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/get_vpn_certs.html
 func (s *RouterService) GetVPNCerts(i *GetVPNCertsInput) (*GetVPNCertsOutput, error) {
+	return s.GetVPNCertsWithContext(context.Background(), i)
+}
+
+// GetVPNCertsWithContext is the same as GetVPNCerts with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) GetVPNCertsWithContext(ctx context.Context, i *GetVPNCertsInput) (*GetVPNCertsOutput, error) {
 	if i == nil {
 		i = &GetVPNCertsInput{}
 	}
@@ -787,8 +1149,12 @@ func (s *RouterService) GetVPNCerts(i *GetVPNCertsInput) (*GetVPNCertsOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -853,6 +1219,12 @@ type GetVPNCertsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/join_router.html
 func (s *RouterService) JoinRouter(i *JoinRouterInput) (*JoinRouterOutput, error) {
+	return s.JoinRouterWithContext(context.Background(), i)
+}
+
+// JoinRouterWithContext is the same as JoinRouter with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) JoinRouterWithContext(ctx context.Context, i *JoinRouterInput) (*JoinRouterOutput, error) {
 	if i == nil {
 		i = &JoinRouterInput{}
 	}
@@ -868,8 +1240,12 @@ func (s *RouterService) JoinRouter(i *JoinRouterInput) (*JoinRouterOutput, error
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -945,6 +1321,12 @@ type JoinRouterOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/leave_router.html
 func (s *RouterService) LeaveRouter(i *LeaveRouterInput) (*LeaveRouterOutput, error) {
+	return s.LeaveRouterWithContext(context.Background(), i)
+}
+
+// LeaveRouterWithContext is the same as LeaveRouter with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) LeaveRouterWithContext(ctx context.Context, i *LeaveRouterInput) (*LeaveRouterOutput, error) {
 	if i == nil {
 		i = &LeaveRouterInput{}
 	}
@@ -960,8 +1342,12 @@ func (s *RouterService) LeaveRouter(i *LeaveRouterInput) (*LeaveRouterOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1004,6 +1390,12 @@ type LeaveRouterOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/modify_router_attributes.html
 func (s *RouterService) ModifyRouterAttributes(i *ModifyRouterAttributesInput) (*ModifyRouterAttributesOutput, error) {
+	return s.ModifyRouterAttributesWithContext(context.Background(), i)
+}
+
+// ModifyRouterAttributesWithContext is the same as ModifyRouterAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) ModifyRouterAttributesWithContext(ctx context.Context, i *ModifyRouterAttributesInput) (*ModifyRouterAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyRouterAttributesInput{}
 	}
@@ -1019,8 +1411,12 @@ func (s *RouterService) ModifyRouterAttributes(i *ModifyRouterAttributesInput) (
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1081,6 +1477,12 @@ type ModifyRouterAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/modify_router_static_attributes.html
 func (s *RouterService) ModifyRouterStaticAttributes(i *ModifyRouterStaticAttributesInput) (*ModifyRouterStaticAttributesOutput, error) {
+	return s.ModifyRouterStaticAttributesWithContext(context.Background(), i)
+}
+
+// ModifyRouterStaticAttributesWithContext is the same as ModifyRouterStaticAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) ModifyRouterStaticAttributesWithContext(ctx context.Context, i *ModifyRouterStaticAttributesInput) (*ModifyRouterStaticAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyRouterStaticAttributesInput{}
 	}
@@ -1096,8 +1498,12 @@ func (s *RouterService) ModifyRouterStaticAttributes(i *ModifyRouterStaticAttrib
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1140,6 +1546,12 @@ type ModifyRouterStaticAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/modify_router_static_entry_attributes.html
 func (s *RouterService) ModifyRouterStaticEntryAttributes(i *ModifyRouterStaticEntryAttributesInput) (*ModifyRouterStaticEntryAttributesOutput, error) {
+	return s.ModifyRouterStaticEntryAttributesWithContext(context.Background(), i)
+}
+
+// ModifyRouterStaticEntryAttributesWithContext is the same as ModifyRouterStaticEntryAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) ModifyRouterStaticEntryAttributesWithContext(ctx context.Context, i *ModifyRouterStaticEntryAttributesInput) (*ModifyRouterStaticEntryAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyRouterStaticEntryAttributesInput{}
 	}
@@ -1155,8 +1567,12 @@ func (s *RouterService) ModifyRouterStaticEntryAttributes(i *ModifyRouterStaticE
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1192,6 +1608,12 @@ type ModifyRouterStaticEntryAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/poweroff_routers.html
 func (s *RouterService) PowerOffRouters(i *PowerOffRoutersInput) (*PowerOffRoutersOutput, error) {
+	return s.PowerOffRoutersWithContext(context.Background(), i)
+}
+
+// PowerOffRoutersWithContext is the same as PowerOffRouters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) PowerOffRoutersWithContext(ctx context.Context, i *PowerOffRoutersInput) (*PowerOffRoutersOutput, error) {
 	if i == nil {
 		i = &PowerOffRoutersInput{}
 	}
@@ -1207,8 +1629,12 @@ func (s *RouterService) PowerOffRouters(i *PowerOffRoutersInput) (*PowerOffRoute
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1241,6 +1667,12 @@ type PowerOffRoutersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/poweron_routers.html
 func (s *RouterService) PowerOnRouters(i *PowerOnRoutersInput) (*PowerOnRoutersOutput, error) {
+	return s.PowerOnRoutersWithContext(context.Background(), i)
+}
+
+// PowerOnRoutersWithContext is the same as PowerOnRouters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) PowerOnRoutersWithContext(ctx context.Context, i *PowerOnRoutersInput) (*PowerOnRoutersOutput, error) {
 	if i == nil {
 		i = &PowerOnRoutersInput{}
 	}
@@ -1256,8 +1688,12 @@ func (s *RouterService) PowerOnRouters(i *PowerOnRoutersInput) (*PowerOnRoutersO
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1290,6 +1726,12 @@ type PowerOnRoutersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/router/update_routers.html
 func (s *RouterService) UpdateRouters(i *UpdateRoutersInput) (*UpdateRoutersOutput, error) {
+	return s.UpdateRoutersWithContext(context.Background(), i)
+}
+
+// UpdateRoutersWithContext is the same as UpdateRouters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *RouterService) UpdateRoutersWithContext(ctx context.Context, i *UpdateRoutersInput) (*UpdateRoutersOutput, error) {
 	if i == nil {
 		i = &UpdateRoutersInput{}
 	}
@@ -1305,8 +1747,12 @@ func (s *RouterService) UpdateRouters(i *UpdateRoutersInput) (*UpdateRoutersOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,664 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package service
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+// Static types of router statics.
+const (
+	RouterStaticTypePortForwarding = 1
+	RouterStaticTypeVPN            = 2
+	RouterStaticTypeDHCP           = 3
+	RouterStaticTypeL2GRETunnel    = 4
+	RouterStaticTypeFiltering      = 5
+	RouterStaticTypeL3GRETunnel    = 6
+	RouterStaticTypeIPsecTunnel    = 7
+	RouterStaticTypeDNS            = 8
+)
+
+// VPN types of VPN router statics.
+const (
+	RouterVPNTypeOpenVPN = "openvpn"
+	RouterVPNTypePPTP    = "pptp"
+)
+
+// TypedRouterStatic is implemented by the typed forms of RouterStatic,
+// whose Val1 to Val9 mean different things for different static types.
+type TypedRouterStatic interface {
+	// Validate checks the values of the router static locally.
+	Validate() error
+	// RouterStatic converts the typed router static to a RouterStatic.
+	RouterStatic() *RouterStatic
+}
+
+// PortForwarding forwards the traffic to SourcePort of the router to
+// PrivatePort of PrivateIP.
+type PortForwarding struct {
+	RouterStaticID   string
+	RouterStaticName string
+	SourcePort       int
+	PrivateIP        string
+	PrivatePort      int
+	// Protocol is tcp or udp, defaults to tcp.
+	Protocol string
+}
+
+// Validate checks the values of the port forwarding.
+func (s *PortForwarding) Validate() error {
+	if err := validatePort("SourcePort", s.SourcePort); err != nil {
+		return err
+	}
+	if err := validateIP("PrivateIP", s.PrivateIP); err != nil {
+		return err
+	}
+	if err := validatePort("PrivatePort", s.PrivatePort); err != nil {
+		return err
+	}
+	return validateAllowedValue("Protocol", s.protocol(), []string{"tcp", "udp"})
+}
+
+// RouterStatic converts the port forwarding to a RouterStatic.
+func (s *PortForwarding) RouterStatic() *RouterStatic {
+	static := newRouterStatic(RouterStaticTypePortForwarding, s.RouterStaticID, s.RouterStaticName)
+	static.Val1 = String(strconv.Itoa(s.SourcePort))
+	static.Val2 = String(s.PrivateIP)
+	static.Val3 = String(strconv.Itoa(s.PrivatePort))
+	static.Val4 = String(s.protocol())
+	return static
+}
+
+func (s *PortForwarding) protocol() string {
+	if s.Protocol == "" {
+		return "tcp"
+	}
+	return s.Protocol
+}
+
+// OpenVPN is an OpenVPN server running on the router.
+type OpenVPN struct {
+	RouterStaticID   string
+	RouterStaticName string
+	// Port defaults to 1194.
+	Port int
+	// Protocol is udp or tcp, defaults to udp.
+	Protocol string
+	// Network is the CIDR of the addresses assigned to clients.
+	Network string
+}
+
+// Validate checks the values of the OpenVPN server.
+func (s *OpenVPN) Validate() error {
+	if s.Port != 0 {
+		if err := validatePort("Port", s.Port); err != nil {
+			return err
+		}
+	}
+	if err := validateAllowedValue("Protocol", s.protocol(), []string{"udp", "tcp"}); err != nil {
+		return err
+	}
+	return validateCIDR("Network", s.Network)
+}
+
+// RouterStatic converts the OpenVPN server to a RouterStatic.
+func (s *OpenVPN) RouterStatic() *RouterStatic {
+	port := s.Port
+	if port == 0 {
+		port = 1194
+	}
+	static := newRouterStatic(RouterStaticTypeVPN, s.RouterStaticID, s.RouterStaticName)
+	static.Val1 = String(RouterVPNTypeOpenVPN)
+	static.Val2 = String(strconv.Itoa(port))
+	static.Val3 = String(s.protocol())
+	static.Val4 = String(s.Network)
+	return static
+}
+
+func (s *OpenVPN) protocol() string {
+	if s.Protocol == "" {
+		return "udp"
+	}
+	return s.Protocol
+}
+
+// PPTPVPN is a PPTP server running on the router.
+type PPTPVPN struct {
+	RouterStaticID   string
+	RouterStaticName string
+	Username         string
+	Password         string
+	// MaxConnections is between 1 and 253.
+	MaxConnections int
+	// Network is the CIDR of the addresses assigned to clients.
+	Network string
+}
+
+// Validate checks the values of the PPTP server.
+func (s *PPTPVPN) Validate() error {
+	if s.Username == "" || strings.Contains(s.Username, ":") {
+		return errors.ParameterValueInvalidError{
+			ParameterName:  "Username",
+			ParameterValue: s.Username,
+			Reason:         "should be non-empty and contain no colon",
+		}
+	}
+	if s.Password == "" {
+		return errors.ParameterRequiredError{
+			ParameterName: "Password",
+			ParentName:    "PPTPVPN",
+		}
+	}
+	if s.MaxConnections < 1 || s.MaxConnections > 253 {
+		return errors.ParameterValueInvalidError{
+			ParameterName:  "MaxConnections",
+			ParameterValue: strconv.Itoa(s.MaxConnections),
+			Reason:         "should be between 1 and 253",
+		}
+	}
+	return validateCIDR("Network", s.Network)
+}
+
+// RouterStatic converts the PPTP server to a RouterStatic.
+func (s *PPTPVPN) RouterStatic() *RouterStatic {
+	static := newRouterStatic(RouterStaticTypeVPN, s.RouterStaticID, s.RouterStaticName)
+	static.Val1 = String(RouterVPNTypePPTP)
+	static.Val2 = String(s.Username + ":" + s.Password)
+	static.Val3 = String(strconv.Itoa(s.MaxConnections))
+	static.Val4 = String(s.Network)
+	return static
+}
+
+// IPsecTunnel is an IPsec tunnel between the router and a remote device.
+type IPsecTunnel struct {
+	RouterStaticID   string
+	RouterStaticName string
+	RemoteIP         string
+	PreSharedKey     string
+	LocalNetworks    []string
+	RemoteNetworks   []string
+}
+
+// Validate checks the values of the IPsec tunnel.
+func (s *IPsecTunnel) Validate() error {
+	if err := validateIP("RemoteIP", s.RemoteIP); err != nil {
+		return err
+	}
+	if s.PreSharedKey == "" {
+		return errors.ParameterRequiredError{
+			ParameterName: "PreSharedKey",
+			ParentName:    "IPsecTunnel",
+		}
+	}
+	if err := validateCIDRs("LocalNetworks", s.LocalNetworks); err != nil {
+		return err
+	}
+	return validateCIDRs("RemoteNetworks", s.RemoteNetworks)
+}
+
+// RouterStatic converts the IPsec tunnel to a RouterStatic.
+func (s *IPsecTunnel) RouterStatic() *RouterStatic {
+	static := newRouterStatic(RouterStaticTypeIPsecTunnel, s.RouterStaticID, s.RouterStaticName)
+	static.Val1 = String(s.RemoteIP)
+	static.Val2 = String(s.PreSharedKey)
+	static.Val3 = String(strings.Join(s.LocalNetworks, "|"))
+	static.Val4 = String(strings.Join(s.RemoteNetworks, "|"))
+	return static
+}
+
+// DHCPOptions are the DHCP options of an instance in the vxnet of the router,
+// such as "fixed-address" and "domain-name-servers".
+type DHCPOptions struct {
+	RouterStaticID   string
+	RouterStaticName string
+	InstanceID       string
+	Options          map[string]string
+}
+
+// Validate checks the values of the DHCP options.
+func (s *DHCPOptions) Validate() error {
+	if s.InstanceID == "" {
+		return errors.ParameterRequiredError{
+			ParameterName: "InstanceID",
+			ParentName:    "DHCPOptions",
+		}
+	}
+	if len(s.Options) == 0 {
+		return errors.ParameterRequiredError{
+			ParameterName: "Options",
+			ParentName:    "DHCPOptions",
+		}
+	}
+	for key, value := range s.Options {
+		if key == "" || strings.ContainsAny(key, "=;") || strings.Contains(value, ";") {
+			return errors.ParameterValueInvalidError{
+				ParameterName:  "Options",
+				ParameterValue: key + "=" + value,
+				Reason:         "keys should be non-empty and contain no \"=\" or \";\"",
+			}
+		}
+	}
+	return nil
+}
+
+// RouterStatic converts the DHCP options to a RouterStatic.
+func (s *DHCPOptions) RouterStatic() *RouterStatic {
+	keys := []string{}
+	for key := range s.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	options := []string{}
+	for _, key := range keys {
+		options = append(options, key+"="+s.Options[key])
+	}
+
+	static := newRouterStatic(RouterStaticTypeDHCP, s.RouterStaticID, s.RouterStaticName)
+	static.Val1 = String(s.InstanceID)
+	static.Val2 = String(strings.Join(options, ";"))
+	return static
+}
+
+// L2GRETunnel is a layer 2 GRE tunnel between the router and a remote device.
+type L2GRETunnel struct {
+	RouterStaticID   string
+	RouterStaticName string
+	RemoteIP         string
+	Key              int
+}
+
+// Validate checks the values of the GRE tunnel.
+func (s *L2GRETunnel) Validate() error {
+	if err := validateIP("RemoteIP", s.RemoteIP); err != nil {
+		return err
+	}
+	return validateGREKey(s.Key)
+}
+
+// RouterStatic converts the GRE tunnel to a RouterStatic.
+func (s *L2GRETunnel) RouterStatic() *RouterStatic {
+	static := newRouterStatic(RouterStaticTypeL2GRETunnel, s.RouterStaticID, s.RouterStaticName)
+	static.Val1 = String(fmt.Sprintf("gre|%s|%d", s.RemoteIP, s.Key))
+	return static
+}
+
+// L3GRETunnel is a layer 3 GRE tunnel between the router and a remote
+// device, routing TargetNetworks through the peer.
+type L3GRETunnel struct {
+	RouterStaticID   string
+	RouterStaticName string
+	RemoteIP         string
+	Key              int
+	LocalP2PIP       string
+	PeerP2PIP        string
+	TargetNetworks   []string
+}
+
+// Validate checks the values of the GRE tunnel.
+func (s *L3GRETunnel) Validate() error {
+	if err := validateIP("RemoteIP", s.RemoteIP); err != nil {
+		return err
+	}
+	if err := validateGREKey(s.Key); err != nil {
+		return err
+	}
+	if err := validateIP("LocalP2PIP", s.LocalP2PIP); err != nil {
+		return err
+	}
+	if err := validateIP("PeerP2PIP", s.PeerP2PIP); err != nil {
+		return err
+	}
+	return validateCIDRs("TargetNetworks", s.TargetNetworks)
+}
+
+// RouterStatic converts the GRE tunnel to a RouterStatic.
+func (s *L3GRETunnel) RouterStatic() *RouterStatic {
+	static := newRouterStatic(RouterStaticTypeL3GRETunnel, s.RouterStaticID, s.RouterStaticName)
+	static.Val1 = String(fmt.Sprintf("gre|%s|%d|%s|%s", s.RemoteIP, s.Key, s.LocalP2PIP, s.PeerP2PIP))
+	static.Val2 = String(strings.Join(s.TargetNetworks, "|"))
+	return static
+}
+
+// FilteringRule controls the traffic forwarded by the router, empty IPs and
+// zero ports match any address and port.
+type FilteringRule struct {
+	RouterStaticID   string
+	RouterStaticName string
+	SourceIP         string
+	SourcePort       int
+	DestinationIP    string
+	DestinationPort  int
+	// Priority is between 1 and 100, smaller is higher.
+	Priority int
+	// Action is accept or drop.
+	Action string
+}
+
+// Validate checks the values of the filtering rule.
+func (s *FilteringRule) Validate() error {
+	if s.SourceIP != "" {
+		if err := validateIPOrCIDR("SourceIP", s.SourceIP); err != nil {
+			return err
+		}
+	}
+	if s.SourcePort != 0 {
+		if err := validatePort("SourcePort", s.SourcePort); err != nil {
+			return err
+		}
+	}
+	if s.DestinationIP != "" {
+		if err := validateIPOrCIDR("DestinationIP", s.DestinationIP); err != nil {
+			return err
+		}
+	}
+	if s.DestinationPort != 0 {
+		if err := validatePort("DestinationPort", s.DestinationPort); err != nil {
+			return err
+		}
+	}
+	if s.Priority < 1 || s.Priority > 100 {
+		return errors.ParameterValueInvalidError{
+			ParameterName:  "Priority",
+			ParameterValue: strconv.Itoa(s.Priority),
+			Reason:         "should be between 1 and 100",
+		}
+	}
+	return validateAllowedValue("Action", s.Action, []string{"accept", "drop"})
+}
+
+// RouterStatic converts the filtering rule to a RouterStatic.
+func (s *FilteringRule) RouterStatic() *RouterStatic {
+	static := newRouterStatic(RouterStaticTypeFiltering, s.RouterStaticID, s.RouterStaticName)
+	static.Val1 = String(s.SourceIP)
+	static.Val2 = String(optionalPort(s.SourcePort))
+	static.Val3 = String(s.DestinationIP)
+	static.Val4 = String(optionalPort(s.DestinationPort))
+	static.Val5 = String(strconv.Itoa(s.Priority))
+	static.Val6 = String(s.Action)
+	return static
+}
+
+// UntypedRouterStatic is a router static of a static type without a typed
+// form, such as RouterStaticTypeDNS, whose values are kept as they are.
+type UntypedRouterStatic struct {
+	Static *RouterStatic
+}
+
+// Validate checks the static type of the router static is set.
+func (s *UntypedRouterStatic) Validate() error {
+	if s.Static == nil || IntValue(s.Static.StaticType) <= 0 {
+		return errors.ParameterRequiredError{ParameterName: "StaticType", ParentName: "RouterStatic"}
+	}
+	return nil
+}
+
+// RouterStatic returns the wrapped RouterStatic.
+func (s *UntypedRouterStatic) RouterStatic() *RouterStatic {
+	return s.Static
+}
+
+// ParseRouterStatic converts the given RouterStatic to its typed form, which
+// is one of *PortForwarding, *OpenVPN, *PPTPVPN, *IPsecTunnel, *DHCPOptions,
+// *L2GRETunnel, *L3GRETunnel and *FilteringRule, and validates it. Router
+// statics of other static types, such as DNS, are returned as
+// *UntypedRouterStatic.
+func ParseRouterStatic(static *RouterStatic) (TypedRouterStatic, error) {
+	id, name := StringValue(static.RouterStaticID), StringValue(static.RouterStaticName)
+	val := func(v *string) string { return StringValue(v) }
+
+	var typed TypedRouterStatic
+	var err error
+	switch IntValue(static.StaticType) {
+	case RouterStaticTypePortForwarding:
+		s := &PortForwarding{RouterStaticID: id, RouterStaticName: name,
+			PrivateIP: val(static.Val2), Protocol: val(static.Val4)}
+		if s.SourcePort, err = atoi("Val1", val(static.Val1)); err != nil {
+			return nil, err
+		}
+		if s.PrivatePort, err = atoi("Val3", val(static.Val3)); err != nil {
+			return nil, err
+		}
+		typed = s
+	case RouterStaticTypeVPN:
+		switch val(static.Val1) {
+		case RouterVPNTypeOpenVPN:
+			s := &OpenVPN{RouterStaticID: id, RouterStaticName: name,
+				Protocol: val(static.Val3), Network: val(static.Val4)}
+			if s.Port, err = atoi("Val2", val(static.Val2)); err != nil {
+				return nil, err
+			}
+			typed = s
+		case RouterVPNTypePPTP:
+			s := &PPTPVPN{RouterStaticID: id, RouterStaticName: name, Network: val(static.Val4)}
+			credentials := strings.SplitN(val(static.Val2), ":", 2)
+			s.Username = credentials[0]
+			if len(credentials) == 2 {
+				s.Password = credentials[1]
+			}
+			if s.MaxConnections, err = atoi("Val3", val(static.Val3)); err != nil {
+				return nil, err
+			}
+			typed = s
+		default:
+			return nil, errors.ParameterValueNotAllowedError{
+				ParameterName:  "Val1",
+				ParameterValue: val(static.Val1),
+				AllowedValues:  []string{RouterVPNTypeOpenVPN, RouterVPNTypePPTP},
+			}
+		}
+	case RouterStaticTypeDHCP:
+		s := &DHCPOptions{RouterStaticID: id, RouterStaticName: name,
+			InstanceID: val(static.Val1), Options: map[string]string{}}
+		for _, option := range splitNonEmpty(val(static.Val2), ";") {
+			kv := strings.SplitN(option, "=", 2)
+			if len(kv) != 2 {
+				return nil, errors.ParameterValueInvalidError{
+					ParameterName:  "Val2",
+					ParameterValue: val(static.Val2),
+					Reason:         "should be options in the \"key=value;key=value\" form",
+				}
+			}
+			s.Options[kv[0]] = kv[1]
+		}
+		typed = s
+	case RouterStaticTypeL2GRETunnel:
+		fields := strings.Split(val(static.Val1), "|")
+		if len(fields) != 3 || fields[0] != "gre" {
+			return nil, errors.ParameterValueInvalidError{
+				ParameterName:  "Val1",
+				ParameterValue: val(static.Val1),
+				Reason:         "should be in the \"gre|remote_ip|key\" form",
+			}
+		}
+		s := &L2GRETunnel{RouterStaticID: id, RouterStaticName: name, RemoteIP: fields[1]}
+		if s.Key, err = atoi("Val1", fields[2]); err != nil {
+			return nil, err
+		}
+		typed = s
+	case RouterStaticTypeL3GRETunnel:
+		fields := strings.Split(val(static.Val1), "|")
+		if len(fields) != 5 || fields[0] != "gre" {
+			return nil, errors.ParameterValueInvalidError{
+				ParameterName:  "Val1",
+				ParameterValue: val(static.Val1),
+				Reason:         "should be in the \"gre|remote_ip|key|local_p2p_ip|peer_p2p_ip\" form",
+			}
+		}
+		s := &L3GRETunnel{RouterStaticID: id, RouterStaticName: name, RemoteIP: fields[1],
+			LocalP2PIP: fields[3], PeerP2PIP: fields[4], TargetNetworks: splitNonEmpty(val(static.Val2), "|")}
+		if s.Key, err = atoi("Val1", fields[2]); err != nil {
+			return nil, err
+		}
+		typed = s
+	case RouterStaticTypeFiltering:
+		s := &FilteringRule{RouterStaticID: id, RouterStaticName: name,
+			SourceIP: val(static.Val1), DestinationIP: val(static.Val3), Action: val(static.Val6)}
+		if s.SourcePort, err = atoi("Val2", val(static.Val2)); err != nil {
+			return nil, err
+		}
+		if s.DestinationPort, err = atoi("Val4", val(static.Val4)); err != nil {
+			return nil, err
+		}
+		if s.Priority, err = atoi("Val5", val(static.Val5)); err != nil {
+			return nil, err
+		}
+		typed = s
+	case RouterStaticTypeIPsecTunnel:
+		typed = &IPsecTunnel{RouterStaticID: id, RouterStaticName: name,
+			RemoteIP: val(static.Val1), PreSharedKey: val(static.Val2),
+			LocalNetworks:  splitNonEmpty(val(static.Val3), "|"),
+			RemoteNetworks: splitNonEmpty(val(static.Val4), "|")}
+	default:
+		typed = &UntypedRouterStatic{Static: static}
+	}
+
+	if err := typed.Validate(); err != nil {
+		return nil, err
+	}
+	return typed, nil
+}
+
+func newRouterStatic(staticType int, id, name string) *RouterStatic {
+	static := &RouterStatic{StaticType: Int(staticType)}
+	if id != "" {
+		static.RouterStaticID = String(id)
+	}
+	if name != "" {
+		static.RouterStaticName = String(name)
+	}
+	return static
+}
+
+func optionalPort(port int) string {
+	if port == 0 {
+		return ""
+	}
+	return strconv.Itoa(port)
+}
+
+func splitNonEmpty(s, sep string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, sep)
+}
+
+func atoi(name, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.ParameterValueInvalidError{
+			ParameterName:  name,
+			ParameterValue: value,
+			Reason:         "should be an integer",
+		}
+	}
+	return i, nil
+}
+
+func validatePort(name string, port int) error {
+	if port < 1 || port > 65535 {
+		return errors.ParameterValueInvalidError{
+			ParameterName:  name,
+			ParameterValue: strconv.Itoa(port),
+			Reason:         "should be between 1 and 65535",
+		}
+	}
+	return nil
+}
+
+func validateGREKey(key int) error {
+	if key < 0 {
+		return errors.ParameterValueInvalidError{
+			ParameterName:  "Key",
+			ParameterValue: strconv.Itoa(key),
+			Reason:         "should not be negative",
+		}
+	}
+	return nil
+}
+
+func validateIP(name, ip string) error {
+	if net.ParseIP(ip) == nil {
+		return errors.ParameterValueInvalidError{
+			ParameterName:  name,
+			ParameterValue: ip,
+			Reason:         "should be an IP",
+		}
+	}
+	return nil
+}
+
+func validateCIDR(name, cidr string) error {
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		return errors.ParameterValueInvalidError{
+			ParameterName:  name,
+			ParameterValue: cidr,
+			Reason:         "should be a CIDR",
+		}
+	}
+	return nil
+}
+
+func validateCIDRs(name string, cidrs []string) error {
+	if len(cidrs) == 0 {
+		return errors.ParameterRequiredError{
+			ParameterName: name,
+			ParentName:    "RouterStatic",
+		}
+	}
+	for _, cidr := range cidrs {
+		if err := validateCIDR(name, cidr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateIPOrCIDR(name, value string) error {
+	if net.ParseIP(value) == nil {
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return errors.ParameterValueInvalidError{
+				ParameterName:  name,
+				ParameterValue: value,
+				Reason:         "should be an IP or a CIDR",
+			}
+		}
+	}
+	return nil
+}
+
+func validateAllowedValue(name, value string, allowedValues []string) error {
+	for _, allowedValue := range allowedValues {
+		if value == allowedValue {
+			return nil
+		}
+	}
+	return errors.ParameterValueNotAllowedError{
+		ParameterName:  name,
+		ParameterValue: value,
+		AllowedValues:  allowedValues,
+	}
+}
@@ -0,0 +1,127 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+func TestRouterStaticRoundTrip(t *testing.T) {
+	statics := []TypedRouterStatic{
+		&PortForwarding{RouterStaticName: "ssh", SourcePort: 2222, PrivateIP: "192.168.0.2", PrivatePort: 22, Protocol: "tcp"},
+		&OpenVPN{RouterStaticID: "rtrs-1", Port: 1194, Protocol: "udp", Network: "10.255.1.0/24"},
+		&PPTPVPN{Username: "user", Password: "pass:word", MaxConnections: 10, Network: "10.255.2.0/24"},
+		&IPsecTunnel{RemoteIP: "1.2.3.4", PreSharedKey: "secret",
+			LocalNetworks: []string{"192.168.0.0/24"}, RemoteNetworks: []string{"172.16.0.0/16", "172.17.0.0/16"}},
+		&DHCPOptions{InstanceID: "i-1", Options: map[string]string{"fixed-address": "192.168.0.10", "domain-name-servers": "8.8.8.8"}},
+		&L2GRETunnel{RemoteIP: "1.2.3.4", Key: 888},
+		&L3GRETunnel{RemoteIP: "1.2.3.4", Key: 888, LocalP2PIP: "10.0.0.1", PeerP2PIP: "10.0.0.2",
+			TargetNetworks: []string{"172.16.0.0/16"}},
+		&FilteringRule{SourceIP: "192.168.0.0/24", DestinationIP: "10.0.0.1", DestinationPort: 80, Priority: 1, Action: "drop"},
+	}
+	for _, static := range statics {
+		assert.Nil(t, static.Validate())
+		parsed, err := ParseRouterStatic(static.RouterStatic())
+		assert.Nil(t, err)
+		assert.Equal(t, static, parsed)
+	}
+}
+
+func TestRouterStaticValues(t *testing.T) {
+	static := (&PortForwarding{SourcePort: 80, PrivateIP: "192.168.0.2", PrivatePort: 8080}).RouterStatic()
+	assert.Equal(t, RouterStaticTypePortForwarding, *static.StaticType)
+	assert.Equal(t, "80", *static.Val1)
+	assert.Equal(t, "192.168.0.2", *static.Val2)
+	assert.Equal(t, "8080", *static.Val3)
+	assert.Equal(t, "tcp", *static.Val4)
+	assert.Nil(t, static.RouterStaticName)
+
+	static = (&OpenVPN{Network: "10.255.1.0/24"}).RouterStatic()
+	assert.Equal(t, "openvpn", *static.Val1)
+	assert.Equal(t, "1194", *static.Val2)
+	assert.Equal(t, "udp", *static.Val3)
+
+	static = (&DHCPOptions{InstanceID: "i-1", Options: map[string]string{"b": "2", "a": "1"}}).RouterStatic()
+	assert.Equal(t, "a=1;b=2", *static.Val2)
+
+	static = (&L3GRETunnel{RemoteIP: "1.2.3.4", Key: 1, LocalP2PIP: "10.0.0.1", PeerP2PIP: "10.0.0.2",
+		TargetNetworks: []string{"172.16.0.0/16", "172.17.0.0/16"}}).RouterStatic()
+	assert.Equal(t, "gre|1.2.3.4|1|10.0.0.1|10.0.0.2", *static.Val1)
+	assert.Equal(t, "172.16.0.0/16|172.17.0.0/16", *static.Val2)
+}
+
+func TestRouterStaticValidate(t *testing.T) {
+	testCases := []struct {
+		static    TypedRouterStatic
+		parameter string
+	}{
+		{&PortForwarding{SourcePort: 0, PrivateIP: "192.168.0.2", PrivatePort: 22}, "SourcePort"},
+		{&PortForwarding{SourcePort: 22, PrivateIP: "192.168.0", PrivatePort: 22}, "PrivateIP"},
+		{&OpenVPN{Port: 70000, Network: "10.255.1.0/24"}, "Port"},
+		{&OpenVPN{Network: "10.255.1.0"}, "Network"},
+		{&PPTPVPN{Username: "a:b", Password: "p", MaxConnections: 1, Network: "10.255.2.0/24"}, "Username"},
+		{&PPTPVPN{Username: "u", Password: "p", MaxConnections: 254, Network: "10.255.2.0/24"}, "MaxConnections"},
+		{&DHCPOptions{InstanceID: "i-1", Options: map[string]string{"a;b": "1"}}, "Options"},
+		{&L2GRETunnel{RemoteIP: "1.2.3.4", Key: -1}, "Key"},
+		{&FilteringRule{Priority: 0, Action: "accept"}, "Priority"},
+		{&FilteringRule{SourceIP: "x", Priority: 1, Action: "accept"}, "SourceIP"},
+	}
+	for _, testCase := range testCases {
+		err := testCase.static.Validate()
+		if assert.IsType(t, errors.ParameterValueInvalidError{}, err) {
+			assert.Equal(t, testCase.parameter, err.(errors.ParameterValueInvalidError).ParameterName)
+		}
+	}
+
+	err := (&PortForwarding{SourcePort: 22, PrivateIP: "192.168.0.2", PrivatePort: 22, Protocol: "icmp"}).Validate()
+	assert.IsType(t, errors.ParameterValueNotAllowedError{}, err)
+	err = (&IPsecTunnel{RemoteIP: "1.2.3.4", PreSharedKey: "secret", LocalNetworks: []string{"192.168.0.0/24"}}).Validate()
+	assert.IsType(t, errors.ParameterRequiredError{}, err)
+	err = (&FilteringRule{Priority: 1, Action: "reject"}).Validate()
+	assert.IsType(t, errors.ParameterValueNotAllowedError{}, err)
+}
+
+func TestParseRouterStaticUntyped(t *testing.T) {
+	static := &RouterStatic{RouterStaticID: String("rtrs-dns"), StaticType: Int(RouterStaticTypeDNS),
+		Val1: String("db.example.com"), Val2: String("192.168.0.10")}
+	typed, err := ParseRouterStatic(static)
+	assert.Nil(t, err)
+	assert.Equal(t, &UntypedRouterStatic{Static: static}, typed)
+	assert.Equal(t, static, typed.RouterStatic())
+}
+
+func TestParseRouterStaticInvalid(t *testing.T) {
+	_, err := ParseRouterStatic(&RouterStatic{})
+	assert.IsType(t, errors.ParameterRequiredError{}, err)
+
+	_, err = ParseRouterStatic(&RouterStatic{StaticType: Int(RouterStaticTypeVPN), Val1: String("l2tp")})
+	assert.IsType(t, errors.ParameterValueNotAllowedError{}, err)
+
+	_, err = ParseRouterStatic(&RouterStatic{StaticType: Int(RouterStaticTypePortForwarding),
+		Val1: String("ssh"), Val2: String("192.168.0.2"), Val3: String("22")})
+	assert.IsType(t, errors.ParameterValueInvalidError{}, err)
+
+	_, err = ParseRouterStatic(&RouterStatic{StaticType: Int(RouterStaticTypeL2GRETunnel), Val1: String("1.2.3.4|888")})
+	assert.IsType(t, errors.ParameterValueInvalidError{}, err)
+
+	_, err = ParseRouterStatic(&RouterStatic{StaticType: Int(RouterStaticTypeDHCP), Val1: String("i-1"), Val2: String("a")})
+	assert.IsType(t, errors.ParameterValueInvalidError{}, err)
+}
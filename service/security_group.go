@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type SecurityGroupService struct {
 	Config     *config.Config
 	Properties *SecurityGroupServiceProperties
+	Handlers   *request.Handlers
 }
 
 type SecurityGroupServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) SecurityGroup(zone string) (*SecurityGroupService, er
 		Zone: &zone,
 	}
 
-	return &SecurityGroupService{Config: s.Config, Properties: properties}, nil
+	return &SecurityGroupService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/add_security_group_rules.html
 func (s *SecurityGroupService) AddSecurityGroupRules(i *AddSecurityGroupRulesInput) (*AddSecurityGroupRulesOutput, error) {
+	return s.AddSecurityGroupRulesWithContext(context.Background(), i)
+}
+
+// AddSecurityGroupRulesWithContext is the same as AddSecurityGroupRules with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) AddSecurityGroupRulesWithContext(ctx context.Context, i *AddSecurityGroupRulesInput) (*AddSecurityGroupRulesOutput, error) {
 	if i == nil {
 		i = &AddSecurityGroupRulesInput{}
 	}
@@ -64,8 +72,12 @@ func (s *SecurityGroupService) AddSecurityGroupRules(i *AddSecurityGroupRulesInp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +126,12 @@ type AddSecurityGroupRulesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/apply_security_group.html
 func (s *SecurityGroupService) ApplySecurityGroup(i *ApplySecurityGroupInput) (*ApplySecurityGroupOutput, error) {
+	return s.ApplySecurityGroupWithContext(context.Background(), i)
+}
+
+// ApplySecurityGroupWithContext is the same as ApplySecurityGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) ApplySecurityGroupWithContext(ctx context.Context, i *ApplySecurityGroupInput) (*ApplySecurityGroupOutput, error) {
 	if i == nil {
 		i = &ApplySecurityGroupInput{}
 	}
@@ -129,8 +147,12 @@ func (s *SecurityGroupService) ApplySecurityGroup(i *ApplySecurityGroupInput) (*
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +186,12 @@ type ApplySecurityGroupOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/apply_security_group_ipsets.html
 func (s *SecurityGroupService) ApplySecurityGroupIPSets(i *ApplySecurityGroupIPSetsInput) (*ApplySecurityGroupIPSetsOutput, error) {
+	return s.ApplySecurityGroupIPSetsWithContext(context.Background(), i)
+}
+
+// ApplySecurityGroupIPSetsWithContext is the same as ApplySecurityGroupIPSets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) ApplySecurityGroupIPSetsWithContext(ctx context.Context, i *ApplySecurityGroupIPSetsInput) (*ApplySecurityGroupIPSetsOutput, error) {
 	if i == nil {
 		i = &ApplySecurityGroupIPSetsInput{}
 	}
@@ -179,8 +207,12 @@ func (s *SecurityGroupService) ApplySecurityGroupIPSets(i *ApplySecurityGroupIPS
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -213,6 +245,12 @@ type ApplySecurityGroupIPSetsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/create_security_group.html
 func (s *SecurityGroupService) CreateSecurityGroup(i *CreateSecurityGroupInput) (*CreateSecurityGroupOutput, error) {
+	return s.CreateSecurityGroupWithContext(context.Background(), i)
+}
+
+// CreateSecurityGroupWithContext is the same as CreateSecurityGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) CreateSecurityGroupWithContext(ctx context.Context, i *CreateSecurityGroupInput) (*CreateSecurityGroupOutput, error) {
 	if i == nil {
 		i = &CreateSecurityGroupInput{}
 	}
@@ -228,8 +266,12 @@ func (s *SecurityGroupService) CreateSecurityGroup(i *CreateSecurityGroupInput)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -255,6 +297,12 @@ type CreateSecurityGroupOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/create_security_group_ipset.html
 func (s *SecurityGroupService) CreateSecurityGroupIPSet(i *CreateSecurityGroupIPSetInput) (*CreateSecurityGroupIPSetOutput, error) {
+	return s.CreateSecurityGroupIPSetWithContext(context.Background(), i)
+}
+
+// CreateSecurityGroupIPSetWithContext is the same as CreateSecurityGroupIPSet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) CreateSecurityGroupIPSetWithContext(ctx context.Context, i *CreateSecurityGroupIPSetInput) (*CreateSecurityGroupIPSetOutput, error) {
 	if i == nil {
 		i = &CreateSecurityGroupIPSetInput{}
 	}
@@ -270,8 +318,12 @@ func (s *SecurityGroupService) CreateSecurityGroupIPSet(i *CreateSecurityGroupIP
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -335,6 +387,12 @@ type CreateSecurityGroupIPSetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/create_security_group_snapshot.html
 func (s *SecurityGroupService) CreateSecurityGroupSnapshot(i *CreateSecurityGroupSnapshotInput) (*CreateSecurityGroupSnapshotOutput, error) {
+	return s.CreateSecurityGroupSnapshotWithContext(context.Background(), i)
+}
+
+// CreateSecurityGroupSnapshotWithContext is the same as CreateSecurityGroupSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) CreateSecurityGroupSnapshotWithContext(ctx context.Context, i *CreateSecurityGroupSnapshotInput) (*CreateSecurityGroupSnapshotOutput, error) {
 	if i == nil {
 		i = &CreateSecurityGroupSnapshotInput{}
 	}
@@ -350,8 +408,12 @@ func (s *SecurityGroupService) CreateSecurityGroupSnapshot(i *CreateSecurityGrou
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -386,6 +448,12 @@ type CreateSecurityGroupSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/delete_security_group_ipsets.html
 func (s *SecurityGroupService) DeleteSecurityGroupIPSets(i *DeleteSecurityGroupIPSetsInput) (*DeleteSecurityGroupIPSetsOutput, error) {
+	return s.DeleteSecurityGroupIPSetsWithContext(context.Background(), i)
+}
+
+// DeleteSecurityGroupIPSetsWithContext is the same as DeleteSecurityGroupIPSets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) DeleteSecurityGroupIPSetsWithContext(ctx context.Context, i *DeleteSecurityGroupIPSetsInput) (*DeleteSecurityGroupIPSetsOutput, error) {
 	if i == nil {
 		i = &DeleteSecurityGroupIPSetsInput{}
 	}
@@ -401,8 +469,12 @@ func (s *SecurityGroupService) DeleteSecurityGroupIPSets(i *DeleteSecurityGroupI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -435,6 +507,12 @@ type DeleteSecurityGroupIPSetsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/delete_security_group_rules.html
 func (s *SecurityGroupService) DeleteSecurityGroupRules(i *DeleteSecurityGroupRulesInput) (*DeleteSecurityGroupRulesOutput, error) {
+	return s.DeleteSecurityGroupRulesWithContext(context.Background(), i)
+}
+
+// DeleteSecurityGroupRulesWithContext is the same as DeleteSecurityGroupRules with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) DeleteSecurityGroupRulesWithContext(ctx context.Context, i *DeleteSecurityGroupRulesInput) (*DeleteSecurityGroupRulesOutput, error) {
 	if i == nil {
 		i = &DeleteSecurityGroupRulesInput{}
 	}
@@ -450,8 +528,12 @@ func (s *SecurityGroupService) DeleteSecurityGroupRules(i *DeleteSecurityGroupRu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -484,6 +566,12 @@ type DeleteSecurityGroupRulesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/delete_security_group_snapshots.html
 func (s *SecurityGroupService) DeleteSecurityGroupSnapshots(i *DeleteSecurityGroupSnapshotsInput) (*DeleteSecurityGroupSnapshotsOutput, error) {
+	return s.DeleteSecurityGroupSnapshotsWithContext(context.Background(), i)
+}
+
+// DeleteSecurityGroupSnapshotsWithContext is the same as DeleteSecurityGroupSnapshots with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) DeleteSecurityGroupSnapshotsWithContext(ctx context.Context, i *DeleteSecurityGroupSnapshotsInput) (*DeleteSecurityGroupSnapshotsOutput, error) {
 	if i == nil {
 		i = &DeleteSecurityGroupSnapshotsInput{}
 	}
@@ -499,8 +587,12 @@ func (s *SecurityGroupService) DeleteSecurityGroupSnapshots(i *DeleteSecurityGro
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -533,6 +625,12 @@ type DeleteSecurityGroupSnapshotsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/delete_security_groups.html
 func (s *SecurityGroupService) DeleteSecurityGroups(i *DeleteSecurityGroupsInput) (*DeleteSecurityGroupsOutput, error) {
+	return s.DeleteSecurityGroupsWithContext(context.Background(), i)
+}
+
+// DeleteSecurityGroupsWithContext is the same as DeleteSecurityGroups with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) DeleteSecurityGroupsWithContext(ctx context.Context, i *DeleteSecurityGroupsInput) (*DeleteSecurityGroupsOutput, error) {
 	if i == nil {
 		i = &DeleteSecurityGroupsInput{}
 	}
@@ -548,8 +646,12 @@ func (s *SecurityGroupService) DeleteSecurityGroups(i *DeleteSecurityGroupsInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -582,6 +684,12 @@ type DeleteSecurityGroupsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/describe_security_group_ipsets.html
 func (s *SecurityGroupService) DescribeSecurityGroupIPSets(i *DescribeSecurityGroupIPSetsInput) (*DescribeSecurityGroupIPSetsOutput, error) {
+	return s.DescribeSecurityGroupIPSetsWithContext(context.Background(), i)
+}
+
+// DescribeSecurityGroupIPSetsWithContext is the same as DescribeSecurityGroupIPSets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) DescribeSecurityGroupIPSetsWithContext(ctx context.Context, i *DescribeSecurityGroupIPSetsInput) (*DescribeSecurityGroupIPSetsOutput, error) {
 	if i == nil {
 		i = &DescribeSecurityGroupIPSetsInput{}
 	}
@@ -597,8 +705,12 @@ func (s *SecurityGroupService) DescribeSecurityGroupIPSets(i *DescribeSecurityGr
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -653,8 +765,67 @@ type DescribeSecurityGroupIPSetsOutput struct {
 	TotalCount            *int                  `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeSecurityGroupIPSetsPaginator pages through the results of DescribeSecurityGroupIPSets.
+type DescribeSecurityGroupIPSetsPaginator struct {
+	request.Pagination
+	service *SecurityGroupService
+	input   DescribeSecurityGroupIPSetsInput
+}
+
+// NewDescribeSecurityGroupIPSetsPaginator create a paginator of DescribeSecurityGroupIPSets starting at
+// the Offset of input.
+func NewDescribeSecurityGroupIPSetsPaginator(s *SecurityGroupService, i *DescribeSecurityGroupIPSetsInput) *DescribeSecurityGroupIPSetsPaginator {
+	if i == nil {
+		i = &DescribeSecurityGroupIPSetsInput{}
+	}
+	return &DescribeSecurityGroupIPSetsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeSecurityGroupIPSets.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeSecurityGroupIPSetsPaginator) NextPage(ctx context.Context) (*DescribeSecurityGroupIPSetsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeSecurityGroupIPSetsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.SecurityGroupIPSetSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeSecurityGroupIPSetsAll returns the SecurityGroupIPSetSet of all the pages of DescribeSecurityGroupIPSets
+// starting at the Offset of input.
+func (s *SecurityGroupService) DescribeSecurityGroupIPSetsAll(ctx context.Context, i *DescribeSecurityGroupIPSetsInput) ([]*SecurityGroupIPSet, error) {
+	p := NewDescribeSecurityGroupIPSetsPaginator(s, i)
+	set := []*SecurityGroupIPSet{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.SecurityGroupIPSetSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/sg/describe_security_group_rules.html
 func (s *SecurityGroupService) DescribeSecurityGroupRules(i *DescribeSecurityGroupRulesInput) (*DescribeSecurityGroupRulesOutput, error) {
+	return s.DescribeSecurityGroupRulesWithContext(context.Background(), i)
+}
+
+// DescribeSecurityGroupRulesWithContext is the same as DescribeSecurityGroupRules with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) DescribeSecurityGroupRulesWithContext(ctx context.Context, i *DescribeSecurityGroupRulesInput) (*DescribeSecurityGroupRulesOutput, error) {
 	if i == nil {
 		i = &DescribeSecurityGroupRulesInput{}
 	}
@@ -670,8 +841,12 @@ func (s *SecurityGroupService) DescribeSecurityGroupRules(i *DescribeSecurityGro
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -723,8 +898,67 @@ type DescribeSecurityGroupRulesOutput struct {
 	TotalCount           *int                 `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeSecurityGroupRulesPaginator pages through the results of DescribeSecurityGroupRules.
+type DescribeSecurityGroupRulesPaginator struct {
+	request.Pagination
+	service *SecurityGroupService
+	input   DescribeSecurityGroupRulesInput
+}
+
+// NewDescribeSecurityGroupRulesPaginator create a paginator of DescribeSecurityGroupRules starting at
+// the Offset of input.
+func NewDescribeSecurityGroupRulesPaginator(s *SecurityGroupService, i *DescribeSecurityGroupRulesInput) *DescribeSecurityGroupRulesPaginator {
+	if i == nil {
+		i = &DescribeSecurityGroupRulesInput{}
+	}
+	return &DescribeSecurityGroupRulesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeSecurityGroupRules.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeSecurityGroupRulesPaginator) NextPage(ctx context.Context) (*DescribeSecurityGroupRulesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeSecurityGroupRulesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.SecurityGroupRuleSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeSecurityGroupRulesAll returns the SecurityGroupRuleSet of all the pages of DescribeSecurityGroupRules
+// starting at the Offset of input.
+func (s *SecurityGroupService) DescribeSecurityGroupRulesAll(ctx context.Context, i *DescribeSecurityGroupRulesInput) ([]*SecurityGroupRule, error) {
+	p := NewDescribeSecurityGroupRulesPaginator(s, i)
+	set := []*SecurityGroupRule{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.SecurityGroupRuleSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/sg/describe_security_group_snapshots.html
 func (s *SecurityGroupService) DescribeSecurityGroupSnapshots(i *DescribeSecurityGroupSnapshotsInput) (*DescribeSecurityGroupSnapshotsOutput, error) {
+	return s.DescribeSecurityGroupSnapshotsWithContext(context.Background(), i)
+}
+
+// DescribeSecurityGroupSnapshotsWithContext is the same as DescribeSecurityGroupSnapshots with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) DescribeSecurityGroupSnapshotsWithContext(ctx context.Context, i *DescribeSecurityGroupSnapshotsInput) (*DescribeSecurityGroupSnapshotsOutput, error) {
 	if i == nil {
 		i = &DescribeSecurityGroupSnapshotsInput{}
 	}
@@ -740,8 +974,12 @@ func (s *SecurityGroupService) DescribeSecurityGroupSnapshots(i *DescribeSecurit
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -778,8 +1016,67 @@ type DescribeSecurityGroupSnapshotsOutput struct {
 	TotalCount               *int                     `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeSecurityGroupSnapshotsPaginator pages through the results of DescribeSecurityGroupSnapshots.
+type DescribeSecurityGroupSnapshotsPaginator struct {
+	request.Pagination
+	service *SecurityGroupService
+	input   DescribeSecurityGroupSnapshotsInput
+}
+
+// NewDescribeSecurityGroupSnapshotsPaginator create a paginator of DescribeSecurityGroupSnapshots starting at
+// the Offset of input.
+func NewDescribeSecurityGroupSnapshotsPaginator(s *SecurityGroupService, i *DescribeSecurityGroupSnapshotsInput) *DescribeSecurityGroupSnapshotsPaginator {
+	if i == nil {
+		i = &DescribeSecurityGroupSnapshotsInput{}
+	}
+	return &DescribeSecurityGroupSnapshotsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeSecurityGroupSnapshots.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeSecurityGroupSnapshotsPaginator) NextPage(ctx context.Context) (*DescribeSecurityGroupSnapshotsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeSecurityGroupSnapshotsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.SecurityGroupSnapshotSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeSecurityGroupSnapshotsAll returns the SecurityGroupSnapshotSet of all the pages of DescribeSecurityGroupSnapshots
+// starting at the Offset of input.
+func (s *SecurityGroupService) DescribeSecurityGroupSnapshotsAll(ctx context.Context, i *DescribeSecurityGroupSnapshotsInput) ([]*SecurityGroupSnapshot, error) {
+	p := NewDescribeSecurityGroupSnapshotsPaginator(s, i)
+	set := []*SecurityGroupSnapshot{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.SecurityGroupSnapshotSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/sg/describe_security_groups.html
 func (s *SecurityGroupService) DescribeSecurityGroups(i *DescribeSecurityGroupsInput) (*DescribeSecurityGroupsOutput, error) {
+	return s.DescribeSecurityGroupsWithContext(context.Background(), i)
+}
+
+// DescribeSecurityGroupsWithContext is the same as DescribeSecurityGroups with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) DescribeSecurityGroupsWithContext(ctx context.Context, i *DescribeSecurityGroupsInput) (*DescribeSecurityGroupsOutput, error) {
 	if i == nil {
 		i = &DescribeSecurityGroupsInput{}
 	}
@@ -795,8 +1092,12 @@ func (s *SecurityGroupService) DescribeSecurityGroups(i *DescribeSecurityGroupsI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -828,8 +1129,67 @@ type DescribeSecurityGroupsOutput struct {
 	TotalCount       *int             `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeSecurityGroupsPaginator pages through the results of DescribeSecurityGroups.
+type DescribeSecurityGroupsPaginator struct {
+	request.Pagination
+	service *SecurityGroupService
+	input   DescribeSecurityGroupsInput
+}
+
+// NewDescribeSecurityGroupsPaginator create a paginator of DescribeSecurityGroups starting at
+// the Offset of input.
+func NewDescribeSecurityGroupsPaginator(s *SecurityGroupService, i *DescribeSecurityGroupsInput) *DescribeSecurityGroupsPaginator {
+	if i == nil {
+		i = &DescribeSecurityGroupsInput{}
+	}
+	return &DescribeSecurityGroupsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeSecurityGroups.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeSecurityGroupsPaginator) NextPage(ctx context.Context) (*DescribeSecurityGroupsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeSecurityGroupsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.SecurityGroupSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeSecurityGroupsAll returns the SecurityGroupSet of all the pages of DescribeSecurityGroups
+// starting at the Offset of input.
+func (s *SecurityGroupService) DescribeSecurityGroupsAll(ctx context.Context, i *DescribeSecurityGroupsInput) ([]*SecurityGroup, error) {
+	p := NewDescribeSecurityGroupsPaginator(s, i)
+	set := []*SecurityGroup{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.SecurityGroupSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/sg/modify_security_group_attributes.html
 func (s *SecurityGroupService) ModifySecurityGroupAttributes(i *ModifySecurityGroupAttributesInput) (*ModifySecurityGroupAttributesOutput, error) {
+	return s.ModifySecurityGroupAttributesWithContext(context.Background(), i)
+}
+
+// ModifySecurityGroupAttributesWithContext is the same as ModifySecurityGroupAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) ModifySecurityGroupAttributesWithContext(ctx context.Context, i *ModifySecurityGroupAttributesInput) (*ModifySecurityGroupAttributesOutput, error) {
 	if i == nil {
 		i = &ModifySecurityGroupAttributesInput{}
 	}
@@ -845,8 +1205,12 @@ func (s *SecurityGroupService) ModifySecurityGroupAttributes(i *ModifySecurityGr
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -881,6 +1245,12 @@ type ModifySecurityGroupAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/modify_security_group_ipset_attributes.html
 func (s *SecurityGroupService) ModifySecurityGroupIPSetAttributes(i *ModifySecurityGroupIPSetAttributesInput) (*ModifySecurityGroupIPSetAttributesOutput, error) {
+	return s.ModifySecurityGroupIPSetAttributesWithContext(context.Background(), i)
+}
+
+// ModifySecurityGroupIPSetAttributesWithContext is the same as ModifySecurityGroupIPSetAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) ModifySecurityGroupIPSetAttributesWithContext(ctx context.Context, i *ModifySecurityGroupIPSetAttributesInput) (*ModifySecurityGroupIPSetAttributesOutput, error) {
 	if i == nil {
 		i = &ModifySecurityGroupIPSetAttributesInput{}
 	}
@@ -896,8 +1266,12 @@ func (s *SecurityGroupService) ModifySecurityGroupIPSetAttributes(i *ModifySecur
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -933,6 +1307,12 @@ type ModifySecurityGroupIPSetAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/modify_security_group_rule_attributes.html
 func (s *SecurityGroupService) ModifySecurityGroupRuleAttributes(i *ModifySecurityGroupRuleAttributesInput) (*ModifySecurityGroupRuleAttributesOutput, error) {
+	return s.ModifySecurityGroupRuleAttributesWithContext(context.Background(), i)
+}
+
+// ModifySecurityGroupRuleAttributesWithContext is the same as ModifySecurityGroupRuleAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) ModifySecurityGroupRuleAttributesWithContext(ctx context.Context, i *ModifySecurityGroupRuleAttributesInput) (*ModifySecurityGroupRuleAttributesOutput, error) {
 	if i == nil {
 		i = &ModifySecurityGroupRuleAttributesInput{}
 	}
@@ -948,8 +1328,12 @@ func (s *SecurityGroupService) ModifySecurityGroupRuleAttributes(i *ModifySecuri
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1034,6 +1418,12 @@ type ModifySecurityGroupRuleAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/sg/rollback_security_group.html
 func (s *SecurityGroupService) RollbackSecurityGroup(i *RollbackSecurityGroupInput) (*RollbackSecurityGroupOutput, error) {
+	return s.RollbackSecurityGroupWithContext(context.Background(), i)
+}
+
+// RollbackSecurityGroupWithContext is the same as RollbackSecurityGroup with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SecurityGroupService) RollbackSecurityGroupWithContext(ctx context.Context, i *RollbackSecurityGroupInput) (*RollbackSecurityGroupOutput, error) {
 	if i == nil {
 		i = &RollbackSecurityGroupInput{}
 	}
@@ -1049,8 +1439,12 @@ func (s *SecurityGroupService) RollbackSecurityGroup(i *RollbackSecurityGroupInp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
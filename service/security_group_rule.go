@@ -0,0 +1,341 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package service
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+// Protocols of security group rules.
+const (
+	SecurityGroupRuleProtocolTCP  = "tcp"
+	SecurityGroupRuleProtocolUDP  = "udp"
+	SecurityGroupRuleProtocolICMP = "icmp"
+	SecurityGroupRuleProtocolGRE  = "gre"
+	SecurityGroupRuleProtocolESP  = "esp"
+	SecurityGroupRuleProtocolAH   = "ah"
+	SecurityGroupRuleProtocolIPIP = "ipip"
+)
+
+// SecurityGroupRuleProtocols lists the protocols a security group rule can use.
+var SecurityGroupRuleProtocols = []string{
+	SecurityGroupRuleProtocolTCP,
+	SecurityGroupRuleProtocolUDP,
+	SecurityGroupRuleProtocolICMP,
+	SecurityGroupRuleProtocolGRE,
+	SecurityGroupRuleProtocolESP,
+	SecurityGroupRuleProtocolAH,
+	SecurityGroupRuleProtocolIPIP,
+}
+
+// MaxSecurityGroupRulePriority is the largest priority of a security group rule.
+const MaxSecurityGroupRulePriority = 100
+
+// ipSetIDPrefix is the prefix of security group IP set IDs.
+const ipSetIDPrefix = "ipset-"
+
+// ipSetIDPattern matches security group IP set IDs.
+var ipSetIDPattern = regexp.MustCompile(`^ipset-[0-9a-z]+$`)
+
+// PortRange is a range of TCP or UDP ports, a zero Start means all ports
+// and a zero End means the single port Start.
+type PortRange struct {
+	Start int
+	End   int
+}
+
+// Port returns a PortRange of the single given port.
+func Port(port int) PortRange {
+	return PortRange{Start: port}
+}
+
+// Ports returns a PortRange from start to end.
+func Ports(start, end int) PortRange {
+	return PortRange{Start: start, End: end}
+}
+
+// String returns the port range in the "start-end" form.
+func (r PortRange) String() string {
+	if r.End == 0 || r.End == r.Start {
+		return strconv.Itoa(r.Start)
+	}
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+func (r PortRange) values() (*string, *string) {
+	if r.Start == 0 {
+		return nil, nil
+	}
+	end := r.End
+	if end == 0 {
+		end = r.Start
+	}
+	return String(strconv.Itoa(r.Start)), String(strconv.Itoa(end))
+}
+
+// TCPRule returns an ingress rule accepting TCP traffic to the given ports
+// from the given source, which is an IP, a CIDR, an IP set ID or empty for
+// any source.
+func TCPRule(ports PortRange, cidr string) *SecurityGroupRule {
+	return portRule(SecurityGroupRuleProtocolTCP, ports, cidr)
+}
+
+// UDPRule returns an ingress rule accepting UDP traffic to the given ports
+// from the given source, which is an IP, a CIDR, an IP set ID or empty for
+// any source.
+func UDPRule(ports PortRange, cidr string) *SecurityGroupRule {
+	return portRule(SecurityGroupRuleProtocolUDP, ports, cidr)
+}
+
+// IPSetRule returns an ingress rule accepting TCP traffic to all ports from
+// the addresses of the given IP set. Use TCPRule or UDPRule with the IP set
+// ID as the source to limit the ports.
+func IPSetRule(ipSetID string) *SecurityGroupRule {
+	return portRule(SecurityGroupRuleProtocolTCP, PortRange{}, ipSetID)
+}
+
+// ICMPRule returns an ingress rule accepting ICMP packets of the given type
+// and code, such as ICMPRule(8, 0) for echo requests.
+func ICMPRule(icmpType, icmpCode int) *SecurityGroupRule {
+	rule := newSecurityGroupRule(SecurityGroupRuleProtocolICMP)
+	rule.Val1 = String(strconv.Itoa(icmpType))
+	rule.Val2 = String(strconv.Itoa(icmpCode))
+	return rule
+}
+
+// GRERule returns an ingress rule accepting GRE packets.
+func GRERule() *SecurityGroupRule {
+	return newSecurityGroupRule(SecurityGroupRuleProtocolGRE)
+}
+
+func portRule(protocol string, ports PortRange, source string) *SecurityGroupRule {
+	rule := newSecurityGroupRule(protocol)
+	rule.Val1, rule.Val2 = ports.values()
+	if source != "" {
+		rule.Val3 = String(source)
+	}
+	return rule
+}
+
+func newSecurityGroupRule(protocol string) *SecurityGroupRule {
+	return &SecurityGroupRule{
+		Protocol:  String(protocol),
+		Direction: Int(0),
+		Action:    String("accept"),
+		Priority:  Int(0),
+	}
+}
+
+// ParsedSecurityGroupRule is the typed form of a SecurityGroupRule, whose
+// Val1, Val2 and Val3 mean different things for different protocols.
+type ParsedSecurityGroupRule struct {
+	Protocol  string
+	Direction int
+	Action    string
+	Priority  int
+
+	// Ports of TCP and UDP rules.
+	Ports PortRange
+	// ICMPType and ICMPCode of ICMP rules, nil matches any type or code.
+	ICMPType *int
+	ICMPCode *int
+	// CIDR is the source IP or CIDR, empty for any source.
+	CIDR string
+	// IPSetID is the source IP set, empty if the source is a CIDR.
+	IPSetID string
+}
+
+// ParseSecurityGroupRule parses and validates the given rule.
+func ParseSecurityGroupRule(rule *SecurityGroupRule) (*ParsedSecurityGroupRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	parsed := &ParsedSecurityGroupRule{
+		Protocol:  StringValue(rule.Protocol),
+		Direction: IntValue(rule.Direction),
+		Action:    StringValue(rule.Action),
+		Priority:  IntValue(rule.Priority),
+	}
+	if parsed.Action == "" {
+		parsed.Action = "accept"
+	}
+	if parsed.Priority < 0 || parsed.Priority > MaxSecurityGroupRulePriority {
+		return nil, errors.ParameterValueInvalidError{
+			ParameterName:  "Priority",
+			ParameterValue: strconv.Itoa(parsed.Priority),
+			Reason:         fmt.Sprintf("should be between 0 and %d", MaxSecurityGroupRulePriority),
+		}
+	}
+
+	val1, val2, val3 := StringValue(rule.Val1), StringValue(rule.Val2), StringValue(rule.Val3)
+	switch parsed.Protocol {
+	case SecurityGroupRuleProtocolTCP, SecurityGroupRuleProtocolUDP:
+		ports, err := parsePortRange(val1, val2)
+		if err != nil {
+			return nil, err
+		}
+		parsed.Ports = ports
+	case SecurityGroupRuleProtocolICMP:
+		icmpType, err := parseIntValue("Val1", val1, 255)
+		if err != nil {
+			return nil, err
+		}
+		icmpCode, err := parseIntValue("Val2", val2, 255)
+		if err != nil {
+			return nil, err
+		}
+		parsed.ICMPType, parsed.ICMPCode = icmpType, icmpCode
+	case SecurityGroupRuleProtocolGRE, SecurityGroupRuleProtocolESP,
+		SecurityGroupRuleProtocolAH, SecurityGroupRuleProtocolIPIP:
+		if val1 != "" || val2 != "" {
+			return nil, errors.ParameterValueInvalidError{
+				ParameterName:  "Val1",
+				ParameterValue: val1 + "," + val2,
+				Reason:         fmt.Sprintf("protocol %s has no ports", parsed.Protocol),
+			}
+		}
+	default:
+		return nil, errors.ParameterValueNotAllowedError{
+			ParameterName:  "Protocol",
+			ParameterValue: parsed.Protocol,
+			AllowedValues:  SecurityGroupRuleProtocols,
+		}
+	}
+
+	switch {
+	case val3 == "":
+	case strings.HasPrefix(val3, ipSetIDPrefix):
+		if !ipSetIDPattern.MatchString(val3) {
+			return nil, errors.ParameterValueInvalidError{
+				ParameterName:  "Val3",
+				ParameterValue: val3,
+				Reason:         "should be an IP set ID like \"ipset-xxxxxxxx\"",
+			}
+		}
+		parsed.IPSetID = val3
+	default:
+		if net.ParseIP(val3) == nil {
+			if _, _, err := net.ParseCIDR(val3); err != nil {
+				return nil, errors.ParameterValueInvalidError{
+					ParameterName:  "Val3",
+					ParameterValue: val3,
+					Reason:         "should be an IP, a CIDR or an IP set ID",
+				}
+			}
+		}
+		parsed.CIDR = val3
+	}
+
+	return parsed, nil
+}
+
+// ValidateSecurityGroupRule checks the protocol specific values of the given
+// rule, it should be called before the rule is sent.
+func ValidateSecurityGroupRule(rule *SecurityGroupRule) error {
+	_, err := ParseSecurityGroupRule(rule)
+	return err
+}
+
+// Rule converts the parsed rule back to a SecurityGroupRule.
+func (r *ParsedSecurityGroupRule) Rule() *SecurityGroupRule {
+	rule := newSecurityGroupRule(r.Protocol)
+	rule.Direction = Int(r.Direction)
+	rule.Priority = Int(r.Priority)
+	if r.Action != "" {
+		rule.Action = String(r.Action)
+	}
+
+	switch r.Protocol {
+	case SecurityGroupRuleProtocolTCP, SecurityGroupRuleProtocolUDP:
+		rule.Val1, rule.Val2 = r.Ports.values()
+	case SecurityGroupRuleProtocolICMP:
+		if r.ICMPType != nil {
+			rule.Val1 = String(strconv.Itoa(*r.ICMPType))
+		}
+		if r.ICMPCode != nil {
+			rule.Val2 = String(strconv.Itoa(*r.ICMPCode))
+		}
+	}
+
+	if r.IPSetID != "" {
+		rule.Val3 = String(r.IPSetID)
+	} else if r.CIDR != "" {
+		rule.Val3 = String(r.CIDR)
+	}
+	return rule
+}
+
+func parsePortRange(val1, val2 string) (PortRange, error) {
+	start, err := parseIntValue("Val1", val1, 65535)
+	if err != nil {
+		return PortRange{}, err
+	}
+	end, err := parseIntValue("Val2", val2, 65535)
+	if err != nil {
+		return PortRange{}, err
+	}
+	if start == nil {
+		if end != nil {
+			return PortRange{}, errors.ParameterRequiredError{
+				ParameterName: "Val1",
+				ParentName:    "SecurityGroupRule",
+			}
+		}
+		return PortRange{}, nil
+	}
+	if *start == 0 {
+		return PortRange{}, errors.ParameterValueInvalidError{
+			ParameterName:  "Val1",
+			ParameterValue: val1,
+			Reason:         "should be between 1 and 65535",
+		}
+	}
+	ports := PortRange{Start: *start, End: *start}
+	if end != nil {
+		if *end < *start {
+			return PortRange{}, errors.ParameterValueInvalidError{
+				ParameterName:  "Val2",
+				ParameterValue: val2,
+				Reason:         fmt.Sprintf("should not be less than start port %d", *start),
+			}
+		}
+		ports.End = *end
+	}
+	return ports, nil
+}
+
+func parseIntValue(name, value string, max int) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 || i > max {
+		return nil, errors.ParameterValueInvalidError{
+			ParameterName:  name,
+			ParameterValue: value,
+			Reason:         fmt.Sprintf("should be between 0 and %d", max),
+		}
+	}
+	return &i, nil
+}
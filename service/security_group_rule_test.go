@@ -0,0 +1,139 @@
+// +-------------------------------------------------------------------------
+// | Copyright (C) 2016 Yunify, Inc.
+// +-------------------------------------------------------------------------
+// | Licensed under the Apache License, Version 2.0 (the "License");
+// | you may not use this work except in compliance with the License.
+// | You may obtain a copy of the License in the LICENSE file, or at:
+// |
+// | http://www.apache.org/licenses/LICENSE-2.0
+// |
+// | Unless required by applicable law or agreed to in writing, software
+// | distributed under the License is distributed on an "AS IS" BASIS,
+// | WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// | See the License for the specific language governing permissions and
+// | limitations under the License.
+// +-------------------------------------------------------------------------
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yunify/qingcloud-sdk-go/request/errors"
+)
+
+func TestSecurityGroupRuleBuilders(t *testing.T) {
+	rule := TCPRule(Port(22), "192.168.0.0/24")
+	assert.Equal(t, "tcp", *rule.Protocol)
+	assert.Equal(t, 0, *rule.Direction)
+	assert.Equal(t, "accept", *rule.Action)
+	assert.Equal(t, "22", *rule.Val1)
+	assert.Equal(t, "22", *rule.Val2)
+	assert.Equal(t, "192.168.0.0/24", *rule.Val3)
+
+	rule = UDPRule(Ports(8000, 9000), "")
+	assert.Equal(t, "udp", *rule.Protocol)
+	assert.Equal(t, "8000", *rule.Val1)
+	assert.Equal(t, "9000", *rule.Val2)
+	assert.Nil(t, rule.Val3)
+
+	rule = UDPRule(PortRange{}, "")
+	assert.Nil(t, rule.Val1)
+	assert.Nil(t, rule.Val2)
+
+	rule = ICMPRule(8, 0)
+	assert.Equal(t, "icmp", *rule.Protocol)
+	assert.Equal(t, "8", *rule.Val1)
+	assert.Equal(t, "0", *rule.Val2)
+
+	rule = GRERule()
+	assert.Equal(t, "gre", *rule.Protocol)
+	assert.Nil(t, rule.Val1)
+
+	rule = IPSetRule("ipset-1")
+	assert.Equal(t, "tcp", *rule.Protocol)
+	assert.Nil(t, rule.Val1)
+	assert.Nil(t, rule.Val2)
+	assert.Equal(t, "ipset-1", *rule.Val3)
+
+	assert.Equal(t, "22", Port(22).String())
+	assert.Equal(t, "8000-9000", Ports(8000, 9000).String())
+}
+
+func TestParseSecurityGroupRule(t *testing.T) {
+	parsed, err := ParseSecurityGroupRule(TCPRule(Ports(8000, 9000), "10.0.0.1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "tcp", parsed.Protocol)
+	assert.Equal(t, Ports(8000, 9000), parsed.Ports)
+	assert.Equal(t, "10.0.0.1", parsed.CIDR)
+	assert.Equal(t, "", parsed.IPSetID)
+
+	parsed, err = ParseSecurityGroupRule(UDPRule(Port(53), "ipset-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, Ports(53, 53), parsed.Ports)
+	assert.Equal(t, "ipset-1", parsed.IPSetID)
+
+	parsed, err = ParseSecurityGroupRule(ICMPRule(8, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, 8, *parsed.ICMPType)
+	assert.Equal(t, 0, *parsed.ICMPCode)
+
+	parsed, err = ParseSecurityGroupRule(&SecurityGroupRule{
+		Protocol: String("icmp"),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, parsed.ICMPType)
+	assert.Equal(t, "accept", parsed.Action)
+
+	for _, rule := range []*SecurityGroupRule{
+		TCPRule(Ports(22, 80), "192.168.0.0/16"),
+		UDPRule(PortRange{}, ""),
+		ICMPRule(0, 0),
+		GRERule(),
+		IPSetRule("ipset-1"),
+		TCPRule(Port(443), "ipset-1"),
+	} {
+		parsed, err := ParseSecurityGroupRule(rule)
+		assert.Nil(t, err)
+		assert.Equal(t, rule, parsed.Rule())
+	}
+}
+
+func TestParseSecurityGroupRuleInvalid(t *testing.T) {
+	testCases := []struct {
+		rule      *SecurityGroupRule
+		parameter string
+	}{
+		{TCPRule(Port(70000), ""), "Val1"},
+		{TCPRule(Ports(80, 22), ""), "Val2"},
+		{TCPRule(Port(22), "10.0.0.0/33"), "Val3"},
+		{TCPRule(Port(22), "example.com"), "Val3"},
+		{TCPRule(Port(22), "sg-1"), "Val3"},
+		{IPSetRule("ipset-"), "Val3"},
+		{IPSetRule("ipset-ABC"), "Val3"},
+		{IPSetRule("ipset-1/24"), "Val3"},
+		{&SecurityGroupRule{Protocol: String("icmp"), Val1: String("80"), Val2: String("80"), Val3: String("ipset")}, "Val3"},
+		{ICMPRule(256, 0), "Val1"},
+		{ICMPRule(8, -1), "Val2"},
+		{&SecurityGroupRule{Protocol: String("gre"), Val1: String("1")}, "Val1"},
+		{&SecurityGroupRule{Protocol: String("tcp"), Val1: String("0")}, "Val1"},
+		{&SecurityGroupRule{Protocol: String("tcp"), Priority: Int(101)}, "Priority"},
+	}
+	for _, testCase := range testCases {
+		_, err := ParseSecurityGroupRule(testCase.rule)
+		if assert.IsType(t, errors.ParameterValueInvalidError{}, err) {
+			assert.Equal(t, testCase.parameter, err.(errors.ParameterValueInvalidError).ParameterName)
+		}
+	}
+
+	_, err := ParseSecurityGroupRule(&SecurityGroupRule{Protocol: String("sctp")})
+	assert.IsType(t, errors.ParameterValueNotAllowedError{}, err)
+
+	_, err = ParseSecurityGroupRule(&SecurityGroupRule{Protocol: String("tcp"), Val2: String("80")})
+	assert.IsType(t, errors.ParameterRequiredError{}, err)
+
+	err = ValidateSecurityGroupRule(&SecurityGroupRule{Protocol: String("tcp"), Action: String("reject")})
+	assert.IsType(t, errors.ParameterValueNotAllowedError{}, err)
+}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type SharedStorageService struct {
 	Config     *config.Config
 	Properties *SharedStorageServiceProperties
+	Handlers   *request.Handlers
 }
 
 type SharedStorageServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) SharedStorage(zone string) (*SharedStorageService, er
 		Zone: &zone,
 	}
 
-	return &SharedStorageService{Config: s.Config, Properties: properties}, nil
+	return &SharedStorageService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/attach_to_s2_shared_target.html
 func (s *SharedStorageService) AttachToS2SharedTarget(i *AttachToS2SharedTargetInput) (*AttachToS2SharedTargetOutput, error) {
+	return s.AttachToS2SharedTargetWithContext(context.Background(), i)
+}
+
+// AttachToS2SharedTargetWithContext is the same as AttachToS2SharedTarget with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) AttachToS2SharedTargetWithContext(ctx context.Context, i *AttachToS2SharedTargetInput) (*AttachToS2SharedTargetOutput, error) {
 	if i == nil {
 		i = &AttachToS2SharedTargetInput{}
 	}
@@ -64,8 +72,12 @@ func (s *SharedStorageService) AttachToS2SharedTarget(i *AttachToS2SharedTargetI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +118,12 @@ type AttachToS2SharedTargetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/change_s2_server_vxnet.html
 func (s *SharedStorageService) ChangeS2ServerVxNet(i *ChangeS2ServerVxNetInput) (*ChangeS2ServerVxNetOutput, error) {
+	return s.ChangeS2ServerVxNetWithContext(context.Background(), i)
+}
+
+// ChangeS2ServerVxNetWithContext is the same as ChangeS2ServerVxNet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) ChangeS2ServerVxNetWithContext(ctx context.Context, i *ChangeS2ServerVxNetInput) (*ChangeS2ServerVxNetOutput, error) {
 	if i == nil {
 		i = &ChangeS2ServerVxNetInput{}
 	}
@@ -121,8 +139,12 @@ func (s *SharedStorageService) ChangeS2ServerVxNet(i *ChangeS2ServerVxNetInput)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +186,12 @@ type ChangeS2ServerVxNetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/create_s2_server.html
 func (s *SharedStorageService) CreateS2Server(i *CreateS2ServerInput) (*CreateS2ServerOutput, error) {
+	return s.CreateS2ServerWithContext(context.Background(), i)
+}
+
+// CreateS2ServerWithContext is the same as CreateS2Server with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) CreateS2ServerWithContext(ctx context.Context, i *CreateS2ServerInput) (*CreateS2ServerOutput, error) {
 	if i == nil {
 		i = &CreateS2ServerInput{}
 	}
@@ -179,8 +207,12 @@ func (s *SharedStorageService) CreateS2Server(i *CreateS2ServerInput) (*CreateS2
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -233,6 +265,12 @@ type CreateS2ServerOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/create_s2_shared_target.html
 func (s *SharedStorageService) CreateS2SharedTarget(i *CreateS2SharedTargetInput) (*CreateS2SharedTargetOutput, error) {
+	return s.CreateS2SharedTargetWithContext(context.Background(), i)
+}
+
+// CreateS2SharedTargetWithContext is the same as CreateS2SharedTarget with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) CreateS2SharedTargetWithContext(ctx context.Context, i *CreateS2SharedTargetInput) (*CreateS2SharedTargetOutput, error) {
 	if i == nil {
 		i = &CreateS2SharedTargetInput{}
 	}
@@ -248,8 +286,12 @@ func (s *SharedStorageService) CreateS2SharedTarget(i *CreateS2SharedTargetInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -324,6 +366,12 @@ type CreateS2SharedTargetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/delete_s2_servers.html
 func (s *SharedStorageService) DeleteS2Servers(i *DeleteS2ServersInput) (*DeleteS2ServersOutput, error) {
+	return s.DeleteS2ServersWithContext(context.Background(), i)
+}
+
+// DeleteS2ServersWithContext is the same as DeleteS2Servers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) DeleteS2ServersWithContext(ctx context.Context, i *DeleteS2ServersInput) (*DeleteS2ServersOutput, error) {
 	if i == nil {
 		i = &DeleteS2ServersInput{}
 	}
@@ -339,8 +387,12 @@ func (s *SharedStorageService) DeleteS2Servers(i *DeleteS2ServersInput) (*Delete
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -374,6 +426,12 @@ type DeleteS2ServersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/delete_s2_shared_targets.html
 func (s *SharedStorageService) DeleteS2SharedTargets(i *DeleteS2SharedTargetsInput) (*DeleteS2SharedTargetsOutput, error) {
+	return s.DeleteS2SharedTargetsWithContext(context.Background(), i)
+}
+
+// DeleteS2SharedTargetsWithContext is the same as DeleteS2SharedTargets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) DeleteS2SharedTargetsWithContext(ctx context.Context, i *DeleteS2SharedTargetsInput) (*DeleteS2SharedTargetsOutput, error) {
 	if i == nil {
 		i = &DeleteS2SharedTargetsInput{}
 	}
@@ -389,8 +447,12 @@ func (s *SharedStorageService) DeleteS2SharedTargets(i *DeleteS2SharedTargetsInp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -423,6 +485,12 @@ type DeleteS2SharedTargetsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/describle_s2_default_parameters.html
 func (s *SharedStorageService) DescribeS2DefaultParameters(i *DescribeS2DefaultParametersInput) (*DescribeS2DefaultParametersOutput, error) {
+	return s.DescribeS2DefaultParametersWithContext(context.Background(), i)
+}
+
+// DescribeS2DefaultParametersWithContext is the same as DescribeS2DefaultParameters with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) DescribeS2DefaultParametersWithContext(ctx context.Context, i *DescribeS2DefaultParametersInput) (*DescribeS2DefaultParametersOutput, error) {
 	if i == nil {
 		i = &DescribeS2DefaultParametersInput{}
 	}
@@ -438,8 +506,12 @@ func (s *SharedStorageService) DescribeS2DefaultParameters(i *DescribeS2DefaultP
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -509,8 +581,67 @@ type DescribeS2DefaultParametersOutput struct {
 	TotalCount             *int                   `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeS2DefaultParametersPaginator pages through the results of DescribeS2DefaultParameters.
+type DescribeS2DefaultParametersPaginator struct {
+	request.Pagination
+	service *SharedStorageService
+	input   DescribeS2DefaultParametersInput
+}
+
+// NewDescribeS2DefaultParametersPaginator create a paginator of DescribeS2DefaultParameters starting at
+// the Offset of input.
+func NewDescribeS2DefaultParametersPaginator(s *SharedStorageService, i *DescribeS2DefaultParametersInput) *DescribeS2DefaultParametersPaginator {
+	if i == nil {
+		i = &DescribeS2DefaultParametersInput{}
+	}
+	return &DescribeS2DefaultParametersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeS2DefaultParameters.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeS2DefaultParametersPaginator) NextPage(ctx context.Context) (*DescribeS2DefaultParametersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeS2DefaultParametersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.S2DefaultParametersSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeS2DefaultParametersAll returns the S2DefaultParametersSet of all the pages of DescribeS2DefaultParameters
+// starting at the Offset of input.
+func (s *SharedStorageService) DescribeS2DefaultParametersAll(ctx context.Context, i *DescribeS2DefaultParametersInput) ([]*S2DefaultParameters, error) {
+	p := NewDescribeS2DefaultParametersPaginator(s, i)
+	set := []*S2DefaultParameters{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.S2DefaultParametersSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/vsan/describe_s2_servers.html
 func (s *SharedStorageService) DescribeS2Servers(i *DescribeS2ServersInput) (*DescribeS2ServersOutput, error) {
+	return s.DescribeS2ServersWithContext(context.Background(), i)
+}
+
+// DescribeS2ServersWithContext is the same as DescribeS2Servers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) DescribeS2ServersWithContext(ctx context.Context, i *DescribeS2ServersInput) (*DescribeS2ServersOutput, error) {
 	if i == nil {
 		i = &DescribeS2ServersInput{}
 	}
@@ -526,8 +657,12 @@ func (s *SharedStorageService) DescribeS2Servers(i *DescribeS2ServersInput) (*De
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -558,8 +693,99 @@ type DescribeS2ServersOutput struct {
 	TotalCount  *int        `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeS2ServersPaginator pages through the results of DescribeS2Servers.
+type DescribeS2ServersPaginator struct {
+	request.Pagination
+	service *SharedStorageService
+	input   DescribeS2ServersInput
+}
+
+// NewDescribeS2ServersPaginator create a paginator of DescribeS2Servers starting at
+// the Offset of input.
+func NewDescribeS2ServersPaginator(s *SharedStorageService, i *DescribeS2ServersInput) *DescribeS2ServersPaginator {
+	if i == nil {
+		i = &DescribeS2ServersInput{}
+	}
+	return &DescribeS2ServersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeS2Servers.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeS2ServersPaginator) NextPage(ctx context.Context) (*DescribeS2ServersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeS2ServersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.S2ServerSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeS2ServersAll returns the S2ServerSet of all the pages of DescribeS2Servers
+// starting at the Offset of input.
+func (s *SharedStorageService) DescribeS2ServersAll(ctx context.Context, i *DescribeS2ServersInput) ([]*S2Server, error) {
+	p := NewDescribeS2ServersPaginator(s, i)
+	set := []*S2Server{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.S2ServerSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilS2ServersStatus waits until all the given S2Servers are in the
+// status without transition status, it fails if any of them is not found.
+func (s *SharedStorageService) WaitUntilS2ServersStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*S2Server, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilS2ServersStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeS2ServersAll(ctx, &DescribeS2ServersInput{
+				S2Servers: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeS2ServersOutput{S2ServerSet: set}, nil
+		},
+		StatusPath:           "S2ServerSet[].Status",
+		TransitionStatusPath: "S2ServerSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeS2ServersOutput).S2ServerSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/vsan/describe_s2_shared_targets.html
 func (s *SharedStorageService) DescribeS2SharedTargets(i *DescribeS2SharedTargetsInput) (*DescribeS2SharedTargetsOutput, error) {
+	return s.DescribeS2SharedTargetsWithContext(context.Background(), i)
+}
+
+// DescribeS2SharedTargetsWithContext is the same as DescribeS2SharedTargets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) DescribeS2SharedTargetsWithContext(ctx context.Context, i *DescribeS2SharedTargetsInput) (*DescribeS2SharedTargetsOutput, error) {
 	if i == nil {
 		i = &DescribeS2SharedTargetsInput{}
 	}
@@ -575,8 +801,12 @@ func (s *SharedStorageService) DescribeS2SharedTargets(i *DescribeS2SharedTarget
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -606,8 +836,67 @@ type DescribeS2SharedTargetsOutput struct {
 	TotalCount      *int              `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeS2SharedTargetsPaginator pages through the results of DescribeS2SharedTargets.
+type DescribeS2SharedTargetsPaginator struct {
+	request.Pagination
+	service *SharedStorageService
+	input   DescribeS2SharedTargetsInput
+}
+
+// NewDescribeS2SharedTargetsPaginator create a paginator of DescribeS2SharedTargets starting at
+// the Offset of input.
+func NewDescribeS2SharedTargetsPaginator(s *SharedStorageService, i *DescribeS2SharedTargetsInput) *DescribeS2SharedTargetsPaginator {
+	if i == nil {
+		i = &DescribeS2SharedTargetsInput{}
+	}
+	return &DescribeS2SharedTargetsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeS2SharedTargets.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeS2SharedTargetsPaginator) NextPage(ctx context.Context) (*DescribeS2SharedTargetsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeS2SharedTargetsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.SharedTargetSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeS2SharedTargetsAll returns the SharedTargetSet of all the pages of DescribeS2SharedTargets
+// starting at the Offset of input.
+func (s *SharedStorageService) DescribeS2SharedTargetsAll(ctx context.Context, i *DescribeS2SharedTargetsInput) ([]*S2SharedTarget, error) {
+	p := NewDescribeS2SharedTargetsPaginator(s, i)
+	set := []*S2SharedTarget{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.SharedTargetSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/vsan/detach_from_s2_shared_target.html
 func (s *SharedStorageService) DetachFromS2SharedTarget(i *DetachFromS2SharedTargetInput) (*DetachFromS2SharedTargetOutput, error) {
+	return s.DetachFromS2SharedTargetWithContext(context.Background(), i)
+}
+
+// DetachFromS2SharedTargetWithContext is the same as DetachFromS2SharedTarget with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) DetachFromS2SharedTargetWithContext(ctx context.Context, i *DetachFromS2SharedTargetInput) (*DetachFromS2SharedTargetOutput, error) {
 	if i == nil {
 		i = &DetachFromS2SharedTargetInput{}
 	}
@@ -623,8 +912,12 @@ func (s *SharedStorageService) DetachFromS2SharedTarget(i *DetachFromS2SharedTar
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -665,6 +958,12 @@ type DetachFromS2SharedTargetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/disable_s2_shared_targets.html
 func (s *SharedStorageService) DisableS2SharedTargets(i *DisableS2SharedTargetsInput) (*DisableS2SharedTargetsOutput, error) {
+	return s.DisableS2SharedTargetsWithContext(context.Background(), i)
+}
+
+// DisableS2SharedTargetsWithContext is the same as DisableS2SharedTargets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) DisableS2SharedTargetsWithContext(ctx context.Context, i *DisableS2SharedTargetsInput) (*DisableS2SharedTargetsOutput, error) {
 	if i == nil {
 		i = &DisableS2SharedTargetsInput{}
 	}
@@ -680,8 +979,12 @@ func (s *SharedStorageService) DisableS2SharedTargets(i *DisableS2SharedTargetsI
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -714,6 +1017,12 @@ type DisableS2SharedTargetsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/enable_s2_shared_targets.html
 func (s *SharedStorageService) EnableS2SharedTargets(i *EnableS2SharedTargetsInput) (*EnableS2SharedTargetsOutput, error) {
+	return s.EnableS2SharedTargetsWithContext(context.Background(), i)
+}
+
+// EnableS2SharedTargetsWithContext is the same as EnableS2SharedTargets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) EnableS2SharedTargetsWithContext(ctx context.Context, i *EnableS2SharedTargetsInput) (*EnableS2SharedTargetsOutput, error) {
 	if i == nil {
 		i = &EnableS2SharedTargetsInput{}
 	}
@@ -729,8 +1038,12 @@ func (s *SharedStorageService) EnableS2SharedTargets(i *EnableS2SharedTargetsInp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -763,6 +1076,12 @@ type EnableS2SharedTargetsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/modify_s2_server.html
 func (s *SharedStorageService) ModifyS2Server(i *ModifyS2ServerInput) (*ModifyS2ServerOutput, error) {
+	return s.ModifyS2ServerWithContext(context.Background(), i)
+}
+
+// ModifyS2ServerWithContext is the same as ModifyS2Server with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) ModifyS2ServerWithContext(ctx context.Context, i *ModifyS2ServerInput) (*ModifyS2ServerOutput, error) {
 	if i == nil {
 		i = &ModifyS2ServerInput{}
 	}
@@ -778,8 +1097,12 @@ func (s *SharedStorageService) ModifyS2Server(i *ModifyS2ServerInput) (*ModifyS2
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -813,6 +1136,12 @@ type ModifyS2ServerOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/modify_s2_shared_target.html
 func (s *SharedStorageService) ModifyS2SharedTargets(i *ModifyS2SharedTargetsInput) (*ModifyS2SharedTargetsOutput, error) {
+	return s.ModifyS2SharedTargetsWithContext(context.Background(), i)
+}
+
+// ModifyS2SharedTargetsWithContext is the same as ModifyS2SharedTargets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) ModifyS2SharedTargetsWithContext(ctx context.Context, i *ModifyS2SharedTargetsInput) (*ModifyS2SharedTargetsOutput, error) {
 	if i == nil {
 		i = &ModifyS2SharedTargetsInput{}
 	}
@@ -828,8 +1157,12 @@ func (s *SharedStorageService) ModifyS2SharedTargets(i *ModifyS2SharedTargetsInp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -878,6 +1211,12 @@ type ModifyS2SharedTargetsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/poweroff_s2_servers.html
 func (s *SharedStorageService) PowerOffS2Servers(i *PowerOffS2ServersInput) (*PowerOffS2ServersOutput, error) {
+	return s.PowerOffS2ServersWithContext(context.Background(), i)
+}
+
+// PowerOffS2ServersWithContext is the same as PowerOffS2Servers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) PowerOffS2ServersWithContext(ctx context.Context, i *PowerOffS2ServersInput) (*PowerOffS2ServersOutput, error) {
 	if i == nil {
 		i = &PowerOffS2ServersInput{}
 	}
@@ -893,8 +1232,12 @@ func (s *SharedStorageService) PowerOffS2Servers(i *PowerOffS2ServersInput) (*Po
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -927,6 +1270,12 @@ type PowerOffS2ServersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/poweron_s2_servers.html
 func (s *SharedStorageService) PowerOnS2Servers(i *PowerOnS2ServersInput) (*PowerOnS2ServersOutput, error) {
+	return s.PowerOnS2ServersWithContext(context.Background(), i)
+}
+
+// PowerOnS2ServersWithContext is the same as PowerOnS2Servers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) PowerOnS2ServersWithContext(ctx context.Context, i *PowerOnS2ServersInput) (*PowerOnS2ServersOutput, error) {
 	if i == nil {
 		i = &PowerOnS2ServersInput{}
 	}
@@ -942,8 +1291,12 @@ func (s *SharedStorageService) PowerOnS2Servers(i *PowerOnS2ServersInput) (*Powe
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -976,6 +1329,12 @@ type PowerOnS2ServersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/resize_s2_servers.html
 func (s *SharedStorageService) ResizeS2Servers(i *ResizeS2ServersInput) (*ResizeS2ServersOutput, error) {
+	return s.ResizeS2ServersWithContext(context.Background(), i)
+}
+
+// ResizeS2ServersWithContext is the same as ResizeS2Servers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) ResizeS2ServersWithContext(ctx context.Context, i *ResizeS2ServersInput) (*ResizeS2ServersOutput, error) {
 	if i == nil {
 		i = &ResizeS2ServersInput{}
 	}
@@ -991,8 +1350,12 @@ func (s *SharedStorageService) ResizeS2Servers(i *ResizeS2ServersInput) (*Resize
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1033,6 +1396,12 @@ type ResizeS2ServersOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vsan/update_s2_servers.html
 func (s *SharedStorageService) UpdateS2Servers(i *UpdateS2ServersInput) (*UpdateS2ServersOutput, error) {
+	return s.UpdateS2ServersWithContext(context.Background(), i)
+}
+
+// UpdateS2ServersWithContext is the same as UpdateS2Servers with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SharedStorageService) UpdateS2ServersWithContext(ctx context.Context, i *UpdateS2ServersInput) (*UpdateS2ServersOutput, error) {
 	if i == nil {
 		i = &UpdateS2ServersInput{}
 	}
@@ -1048,8 +1417,12 @@ func (s *SharedStorageService) UpdateS2Servers(i *UpdateS2ServersInput) (*Update
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type SnapshotService struct {
 	Config     *config.Config
 	Properties *SnapshotServiceProperties
+	Handlers   *request.Handlers
 }
 
 type SnapshotServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Snapshot(zone string) (*SnapshotService, error) {
 		Zone: &zone,
 	}
 
-	return &SnapshotService{Config: s.Config, Properties: properties}, nil
+	return &SnapshotService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/snapshot/apply_snapshots.html
 func (s *SnapshotService) ApplySnapshots(i *ApplySnapshotsInput) (*ApplySnapshotsOutput, error) {
+	return s.ApplySnapshotsWithContext(context.Background(), i)
+}
+
+// ApplySnapshotsWithContext is the same as ApplySnapshots with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SnapshotService) ApplySnapshotsWithContext(ctx context.Context, i *ApplySnapshotsInput) (*ApplySnapshotsOutput, error) {
 	if i == nil {
 		i = &ApplySnapshotsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *SnapshotService) ApplySnapshots(i *ApplySnapshotsInput) (*ApplySnapshot
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +110,12 @@ type ApplySnapshotsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/snapshot/capture_instance_from_snapshot.html
 func (s *SnapshotService) CaptureInstanceFromSnapshot(i *CaptureInstanceFromSnapshotInput) (*CaptureInstanceFromSnapshotOutput, error) {
+	return s.CaptureInstanceFromSnapshotWithContext(context.Background(), i)
+}
+
+// CaptureInstanceFromSnapshotWithContext is the same as CaptureInstanceFromSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SnapshotService) CaptureInstanceFromSnapshotWithContext(ctx context.Context, i *CaptureInstanceFromSnapshotInput) (*CaptureInstanceFromSnapshotOutput, error) {
 	if i == nil {
 		i = &CaptureInstanceFromSnapshotInput{}
 	}
@@ -113,8 +131,12 @@ func (s *SnapshotService) CaptureInstanceFromSnapshot(i *CaptureInstanceFromSnap
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +171,12 @@ type CaptureInstanceFromSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/snapshot/create_snapshots.html
 func (s *SnapshotService) CreateSnapshots(i *CreateSnapshotsInput) (*CreateSnapshotsOutput, error) {
+	return s.CreateSnapshotsWithContext(context.Background(), i)
+}
+
+// CreateSnapshotsWithContext is the same as CreateSnapshots with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SnapshotService) CreateSnapshotsWithContext(ctx context.Context, i *CreateSnapshotsInput) (*CreateSnapshotsOutput, error) {
 	if i == nil {
 		i = &CreateSnapshotsInput{}
 	}
@@ -164,8 +192,12 @@ func (s *SnapshotService) CreateSnapshots(i *CreateSnapshotsInput) (*CreateSnaps
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -224,6 +256,12 @@ type CreateSnapshotsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/snapshot/create_volume_from_snapshot.html
 func (s *SnapshotService) CreateVolumeFromSnapshot(i *CreateVolumeFromSnapshotInput) (*CreateVolumeFromSnapshotOutput, error) {
+	return s.CreateVolumeFromSnapshotWithContext(context.Background(), i)
+}
+
+// CreateVolumeFromSnapshotWithContext is the same as CreateVolumeFromSnapshot with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SnapshotService) CreateVolumeFromSnapshotWithContext(ctx context.Context, i *CreateVolumeFromSnapshotInput) (*CreateVolumeFromSnapshotOutput, error) {
 	if i == nil {
 		i = &CreateVolumeFromSnapshotInput{}
 	}
@@ -239,8 +277,12 @@ func (s *SnapshotService) CreateVolumeFromSnapshot(i *CreateVolumeFromSnapshotIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -276,6 +318,12 @@ type CreateVolumeFromSnapshotOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/snapshot/delete_snapshots.html
 func (s *SnapshotService) DeleteSnapshots(i *DeleteSnapshotsInput) (*DeleteSnapshotsOutput, error) {
+	return s.DeleteSnapshotsWithContext(context.Background(), i)
+}
+
+// DeleteSnapshotsWithContext is the same as DeleteSnapshots with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SnapshotService) DeleteSnapshotsWithContext(ctx context.Context, i *DeleteSnapshotsInput) (*DeleteSnapshotsOutput, error) {
 	if i == nil {
 		i = &DeleteSnapshotsInput{}
 	}
@@ -291,8 +339,12 @@ func (s *SnapshotService) DeleteSnapshots(i *DeleteSnapshotsInput) (*DeleteSnaps
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -325,6 +377,12 @@ type DeleteSnapshotsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/snapshot/describe_snapshots.html
 func (s *SnapshotService) DescribeSnapshots(i *DescribeSnapshotsInput) (*DescribeSnapshotsOutput, error) {
+	return s.DescribeSnapshotsWithContext(context.Background(), i)
+}
+
+// DescribeSnapshotsWithContext is the same as DescribeSnapshots with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SnapshotService) DescribeSnapshotsWithContext(ctx context.Context, i *DescribeSnapshotsInput) (*DescribeSnapshotsOutput, error) {
 	if i == nil {
 		i = &DescribeSnapshotsInput{}
 	}
@@ -340,8 +398,12 @@ func (s *SnapshotService) DescribeSnapshots(i *DescribeSnapshotsInput) (*Describ
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -420,8 +482,99 @@ type DescribeSnapshotsOutput struct {
 	TotalCount  *int        `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeSnapshotsPaginator pages through the results of DescribeSnapshots.
+type DescribeSnapshotsPaginator struct {
+	request.Pagination
+	service *SnapshotService
+	input   DescribeSnapshotsInput
+}
+
+// NewDescribeSnapshotsPaginator create a paginator of DescribeSnapshots starting at
+// the Offset of input.
+func NewDescribeSnapshotsPaginator(s *SnapshotService, i *DescribeSnapshotsInput) *DescribeSnapshotsPaginator {
+	if i == nil {
+		i = &DescribeSnapshotsInput{}
+	}
+	return &DescribeSnapshotsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeSnapshots.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeSnapshotsPaginator) NextPage(ctx context.Context) (*DescribeSnapshotsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeSnapshotsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.SnapshotSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeSnapshotsAll returns the SnapshotSet of all the pages of DescribeSnapshots
+// starting at the Offset of input.
+func (s *SnapshotService) DescribeSnapshotsAll(ctx context.Context, i *DescribeSnapshotsInput) ([]*Snapshot, error) {
+	p := NewDescribeSnapshotsPaginator(s, i)
+	set := []*Snapshot{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.SnapshotSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilSnapshotsStatus waits until all the given Snapshots are in the
+// status without transition status, it fails if any of them is not found.
+func (s *SnapshotService) WaitUntilSnapshotsStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*Snapshot, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilSnapshotsStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeSnapshotsAll(ctx, &DescribeSnapshotsInput{
+				Snapshots: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeSnapshotsOutput{SnapshotSet: set}, nil
+		},
+		StatusPath:           "SnapshotSet[].Status",
+		TransitionStatusPath: "SnapshotSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeSnapshotsOutput).SnapshotSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/snapshot/modify_snapshot_attributes.html
 func (s *SnapshotService) ModifySnapshotAttributes(i *ModifySnapshotAttributesInput) (*ModifySnapshotAttributesOutput, error) {
+	return s.ModifySnapshotAttributesWithContext(context.Background(), i)
+}
+
+// ModifySnapshotAttributesWithContext is the same as ModifySnapshotAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *SnapshotService) ModifySnapshotAttributesWithContext(ctx context.Context, i *ModifySnapshotAttributesInput) (*ModifySnapshotAttributesOutput, error) {
 	if i == nil {
 		i = &ModifySnapshotAttributesInput{}
 	}
@@ -437,8 +590,12 @@ func (s *SnapshotService) ModifySnapshotAttributes(i *ModifySnapshotAttributesIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type TagService struct {
 	Config     *config.Config
 	Properties *TagServiceProperties
+	Handlers   *request.Handlers
 }
 
 type TagServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) Tag(zone string) (*TagService, error) {
 		Zone: &zone,
 	}
 
-	return &TagService{Config: s.Config, Properties: properties}, nil
+	return &TagService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/tag/attach_tags.html
 func (s *TagService) AttachTags(i *AttachTagsInput) (*AttachTagsOutput, error) {
+	return s.AttachTagsWithContext(context.Background(), i)
+}
+
+// AttachTagsWithContext is the same as AttachTags with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *TagService) AttachTagsWithContext(ctx context.Context, i *AttachTagsInput) (*AttachTagsOutput, error) {
 	if i == nil {
 		i = &AttachTagsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *TagService) AttachTags(i *AttachTagsInput) (*AttachTagsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +117,12 @@ type AttachTagsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/tag/create_tag.html
 func (s *TagService) CreateTag(i *CreateTagInput) (*CreateTagOutput, error) {
+	return s.CreateTagWithContext(context.Background(), i)
+}
+
+// CreateTagWithContext is the same as CreateTag with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *TagService) CreateTagWithContext(ctx context.Context, i *CreateTagInput) (*CreateTagOutput, error) {
 	if i == nil {
 		i = &CreateTagInput{}
 	}
@@ -120,8 +138,12 @@ func (s *TagService) CreateTag(i *CreateTagInput) (*CreateTagOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +170,12 @@ type CreateTagOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/tag/delete_tags.html
 func (s *TagService) DeleteTags(i *DeleteTagsInput) (*DeleteTagsOutput, error) {
+	return s.DeleteTagsWithContext(context.Background(), i)
+}
+
+// DeleteTagsWithContext is the same as DeleteTags with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *TagService) DeleteTagsWithContext(ctx context.Context, i *DeleteTagsInput) (*DeleteTagsOutput, error) {
 	if i == nil {
 		i = &DeleteTagsInput{}
 	}
@@ -163,8 +191,12 @@ func (s *TagService) DeleteTags(i *DeleteTagsInput) (*DeleteTagsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +229,12 @@ type DeleteTagsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/tag/describe_tags.html
 func (s *TagService) DescribeTags(i *DescribeTagsInput) (*DescribeTagsOutput, error) {
+	return s.DescribeTagsWithContext(context.Background(), i)
+}
+
+// DescribeTagsWithContext is the same as DescribeTags with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *TagService) DescribeTagsWithContext(ctx context.Context, i *DescribeTagsInput) (*DescribeTagsOutput, error) {
 	if i == nil {
 		i = &DescribeTagsInput{}
 	}
@@ -212,8 +250,12 @@ func (s *TagService) DescribeTags(i *DescribeTagsInput) (*DescribeTagsOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +305,67 @@ type DescribeTagsOutput struct {
 	TotalCount *int    `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeTagsPaginator pages through the results of DescribeTags.
+type DescribeTagsPaginator struct {
+	request.Pagination
+	service *TagService
+	input   DescribeTagsInput
+}
+
+// NewDescribeTagsPaginator create a paginator of DescribeTags starting at
+// the Offset of input.
+func NewDescribeTagsPaginator(s *TagService, i *DescribeTagsInput) *DescribeTagsPaginator {
+	if i == nil {
+		i = &DescribeTagsInput{}
+	}
+	return &DescribeTagsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeTags.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeTagsPaginator) NextPage(ctx context.Context) (*DescribeTagsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeTagsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.TagSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeTagsAll returns the TagSet of all the pages of DescribeTags
+// starting at the Offset of input.
+func (s *TagService) DescribeTagsAll(ctx context.Context, i *DescribeTagsInput) ([]*Tag, error) {
+	p := NewDescribeTagsPaginator(s, i)
+	set := []*Tag{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.TagSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/tag/detach_tags.html
 func (s *TagService) DetachTags(i *DetachTagsInput) (*DetachTagsOutput, error) {
+	return s.DetachTagsWithContext(context.Background(), i)
+}
+
+// DetachTagsWithContext is the same as DetachTags with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *TagService) DetachTagsWithContext(ctx context.Context, i *DetachTagsInput) (*DetachTagsOutput, error) {
 	if i == nil {
 		i = &DetachTagsInput{}
 	}
@@ -280,8 +381,12 @@ func (s *TagService) DetachTags(i *DetachTagsInput) (*DetachTagsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -321,6 +426,12 @@ type DetachTagsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/tag/modify_tag_attributes.html
 func (s *TagService) ModifyTagAttributes(i *ModifyTagAttributesInput) (*ModifyTagAttributesOutput, error) {
+	return s.ModifyTagAttributesWithContext(context.Background(), i)
+}
+
+// ModifyTagAttributesWithContext is the same as ModifyTagAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *TagService) ModifyTagAttributesWithContext(ctx context.Context, i *ModifyTagAttributesInput) (*ModifyTagAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyTagAttributesInput{}
 	}
@@ -336,8 +447,12 @@ func (s *TagService) ModifyTagAttributes(i *ModifyTagAttributesInput) (*ModifyTa
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type UserDataService struct {
 	Config     *config.Config
 	Properties *UserDataServiceProperties
+	Handlers   *request.Handlers
 }
 
 type UserDataServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) UserData(zone string) (*UserDataService, error) {
 		Zone: &zone,
 	}
 
-	return &UserDataService{Config: s.Config, Properties: properties}, nil
+	return &UserDataService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/userdata/upload_userdata_attachment.html
 func (s *UserDataService) UploadUserDataAttachment(i *UploadUserDataAttachmentInput) (*UploadUserDataAttachmentOutput, error) {
+	return s.UploadUserDataAttachmentWithContext(context.Background(), i)
+}
+
+// UploadUserDataAttachmentWithContext is the same as UploadUserDataAttachment with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *UserDataService) UploadUserDataAttachmentWithContext(ctx context.Context, i *UploadUserDataAttachmentInput) (*UploadUserDataAttachmentOutput, error) {
 	if i == nil {
 		i = &UploadUserDataAttachmentInput{}
 	}
@@ -64,8 +72,12 @@ func (s *UserDataService) UploadUserDataAttachment(i *UploadUserDataAttachmentIn
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type VIPService struct {
 	Config     *config.Config
 	Properties *VIPServiceProperties
+	Handlers   *request.Handlers
 }
 
 type VIPServiceProperties struct {
@@ -44,10 +46,16 @@ func (s *QingCloudService) VIP(zone string) (*VIPService, error) {
 		Zone: &zone,
 	}
 
-	return &VIPService{Config: s.Config, Properties: properties}, nil
+	return &VIPService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 func (s *VIPService) CreateVIPs(i *CreateVIPsInput) (*CreateVIPsOutput, error) {
+	return s.CreateVIPsWithContext(context.Background(), i)
+}
+
+// CreateVIPsWithContext is the same as CreateVIPs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VIPService) CreateVIPsWithContext(ctx context.Context, i *CreateVIPsInput) (*CreateVIPsOutput, error) {
 	if i == nil {
 		i = &CreateVIPsInput{}
 	}
@@ -63,8 +71,12 @@ func (s *VIPService) CreateVIPs(i *CreateVIPsInput) (*CreateVIPsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +111,12 @@ type CreateVIPsOutput struct {
 }
 
 func (s *VIPService) DeleteVIPs(i *DeleteVIPsInput) (*DeleteVIPsOutput, error) {
+	return s.DeleteVIPsWithContext(context.Background(), i)
+}
+
+// DeleteVIPsWithContext is the same as DeleteVIPs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VIPService) DeleteVIPsWithContext(ctx context.Context, i *DeleteVIPsInput) (*DeleteVIPsOutput, error) {
 	if i == nil {
 		i = &DeleteVIPsInput{}
 	}
@@ -114,8 +132,12 @@ func (s *VIPService) DeleteVIPs(i *DeleteVIPsInput) (*DeleteVIPsOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -147,6 +169,12 @@ type DeleteVIPsOutput struct {
 }
 
 func (s *VIPService) DescribeVxNetsVIPs(i *DescribeVxNetsVIPsInput) (*DescribeVxNetsVIPsOutput, error) {
+	return s.DescribeVxNetsVIPsWithContext(context.Background(), i)
+}
+
+// DescribeVxNetsVIPsWithContext is the same as DescribeVxNetsVIPs with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VIPService) DescribeVxNetsVIPsWithContext(ctx context.Context, i *DescribeVxNetsVIPsInput) (*DescribeVxNetsVIPsOutput, error) {
 	if i == nil {
 		i = &DescribeVxNetsVIPsInput{}
 	}
@@ -162,8 +190,12 @@ func (s *VIPService) DescribeVxNetsVIPs(i *DescribeVxNetsVIPsInput) (*DescribeVx
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -197,3 +229,56 @@ type DescribeVxNetsVIPsOutput struct {
 	RetCode    *int    `json:"ret_code" name:"ret_code" location:"elements"`
 	TotalCount *int    `json:"total_count" name:"total_count" location:"elements"`
 }
+
+// DescribeVxNetsVIPsPaginator pages through the results of DescribeVxNetsVIPs.
+type DescribeVxNetsVIPsPaginator struct {
+	request.Pagination
+	service *VIPService
+	input   DescribeVxNetsVIPsInput
+}
+
+// NewDescribeVxNetsVIPsPaginator create a paginator of DescribeVxNetsVIPs starting at
+// the Offset of input.
+func NewDescribeVxNetsVIPsPaginator(s *VIPService, i *DescribeVxNetsVIPsInput) *DescribeVxNetsVIPsPaginator {
+	if i == nil {
+		i = &DescribeVxNetsVIPsInput{}
+	}
+	return &DescribeVxNetsVIPsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeVxNetsVIPs.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeVxNetsVIPsPaginator) NextPage(ctx context.Context) (*DescribeVxNetsVIPsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeVxNetsVIPsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.VIPSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeVxNetsVIPsAll returns the VIPSet of all the pages of DescribeVxNetsVIPs
+// starting at the Offset of input.
+func (s *VIPService) DescribeVxNetsVIPsAll(ctx context.Context, i *DescribeVxNetsVIPsInput) ([]*VIP, error) {
+	p := NewDescribeVxNetsVIPsPaginator(s, i)
+	set := []*VIP{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.VIPSet...)
+	}
+	return set, nil
+}
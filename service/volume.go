@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -34,6 +35,7 @@ var _volumeTypeValidValues = []string{"0", "1", "2", "3", "4", "5", "6", "7", "1
 type VolumeService struct {
 	Config     *config.Config
 	Properties *VolumeServiceProperties
+	Handlers   *request.Handlers
 }
 
 type VolumeServiceProperties struct {
@@ -46,11 +48,17 @@ func (s *QingCloudService) Volume(zone string) (*VolumeService, error) {
 		Zone: &zone,
 	}
 
-	return &VolumeService{Config: s.Config, Properties: properties}, nil
+	return &VolumeService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/volume/attach_volumes.html
 func (s *VolumeService) AttachVolumes(i *AttachVolumesInput) (*AttachVolumesOutput, error) {
+	return s.AttachVolumesWithContext(context.Background(), i)
+}
+
+// AttachVolumesWithContext is the same as AttachVolumes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VolumeService) AttachVolumesWithContext(ctx context.Context, i *AttachVolumesInput) (*AttachVolumesOutput, error) {
 	if i == nil {
 		i = &AttachVolumesInput{}
 	}
@@ -66,8 +74,12 @@ func (s *VolumeService) AttachVolumes(i *AttachVolumesInput) (*AttachVolumesOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +120,12 @@ type AttachVolumesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com
 func (s *VolumeService) CloneVolumes(i *CloneVolumesInput) (*CloneVolumesOutput, error) {
+	return s.CloneVolumesWithContext(context.Background(), i)
+}
+
+// CloneVolumesWithContext is the same as CloneVolumes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VolumeService) CloneVolumesWithContext(ctx context.Context, i *CloneVolumesInput) (*CloneVolumesOutput, error) {
 	if i == nil {
 		i = &CloneVolumesInput{}
 	}
@@ -123,8 +141,12 @@ func (s *VolumeService) CloneVolumes(i *CloneVolumesInput) (*CloneVolumesOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +203,12 @@ type CloneVolumesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/volume/create_volumes.html
 func (s *VolumeService) CreateVolumes(i *CreateVolumesInput) (*CreateVolumesOutput, error) {
+	return s.CreateVolumesWithContext(context.Background(), i)
+}
+
+// CreateVolumesWithContext is the same as CreateVolumes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VolumeService) CreateVolumesWithContext(ctx context.Context, i *CreateVolumesInput) (*CreateVolumesOutput, error) {
 	if i == nil {
 		i = &CreateVolumesInput{}
 	}
@@ -196,8 +224,12 @@ func (s *VolumeService) CreateVolumes(i *CreateVolumesInput) (*CreateVolumesOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -299,6 +331,12 @@ type CreateVolumesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/volume/delete_volumes.html
 func (s *VolumeService) DeleteVolumes(i *DeleteVolumesInput) (*DeleteVolumesOutput, error) {
+	return s.DeleteVolumesWithContext(context.Background(), i)
+}
+
+// DeleteVolumesWithContext is the same as DeleteVolumes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VolumeService) DeleteVolumesWithContext(ctx context.Context, i *DeleteVolumesInput) (*DeleteVolumesOutput, error) {
 	if i == nil {
 		i = &DeleteVolumesInput{}
 	}
@@ -314,8 +352,12 @@ func (s *VolumeService) DeleteVolumes(i *DeleteVolumesInput) (*DeleteVolumesOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +395,12 @@ type DeleteVolumesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/volume/describe_volumes.html
 func (s *VolumeService) DescribeVolumes(i *DescribeVolumesInput) (*DescribeVolumesOutput, error) {
+	return s.DescribeVolumesWithContext(context.Background(), i)
+}
+
+// DescribeVolumesWithContext is the same as DescribeVolumes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VolumeService) DescribeVolumesWithContext(ctx context.Context, i *DescribeVolumesInput) (*DescribeVolumesOutput, error) {
 	if i == nil {
 		i = &DescribeVolumesInput{}
 	}
@@ -368,8 +416,12 @@ func (s *VolumeService) DescribeVolumes(i *DescribeVolumesInput) (*DescribeVolum
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -464,8 +516,99 @@ type DescribeVolumesOutput struct {
 	VolumeSet  []*Volume `json:"volume_set" name:"volume_set" location:"elements"`
 }
 
+// DescribeVolumesPaginator pages through the results of DescribeVolumes.
+type DescribeVolumesPaginator struct {
+	request.Pagination
+	service *VolumeService
+	input   DescribeVolumesInput
+}
+
+// NewDescribeVolumesPaginator create a paginator of DescribeVolumes starting at
+// the Offset of input.
+func NewDescribeVolumesPaginator(s *VolumeService, i *DescribeVolumesInput) *DescribeVolumesPaginator {
+	if i == nil {
+		i = &DescribeVolumesInput{}
+	}
+	return &DescribeVolumesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeVolumes.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeVolumesPaginator) NextPage(ctx context.Context) (*DescribeVolumesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeVolumesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.VolumeSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeVolumesAll returns the VolumeSet of all the pages of DescribeVolumes
+// starting at the Offset of input.
+func (s *VolumeService) DescribeVolumesAll(ctx context.Context, i *DescribeVolumesInput) ([]*Volume, error) {
+	p := NewDescribeVolumesPaginator(s, i)
+	set := []*Volume{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.VolumeSet...)
+	}
+	return set, nil
+}
+
+// WaitUntilVolumesStatus waits until all the given Volumes are in the
+// status without transition status, it fails if any of them is not found.
+func (s *VolumeService) WaitUntilVolumesStatus(ctx context.Context, ids []*string, status string, options ...request.WaiterOption) ([]*Volume, error) {
+	w := &request.Waiter{
+		Name: "WaitUntilVolumesStatus",
+		Describe: func(ctx context.Context) (interface{}, error) {
+			// Fetch all the pages, the ids may not fit in one.
+			set, err := s.DescribeVolumesAll(ctx, &DescribeVolumesInput{
+				Volumes: ids,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &DescribeVolumesOutput{VolumeSet: set}, nil
+		},
+		StatusPath:           "VolumeSet[].Status",
+		TransitionStatusPath: "VolumeSet[].TransitionStatus",
+		Count:                len(ids),
+		Acceptors: []request.WaiterAcceptor{
+			{State: request.SuccessWaiterState, Matcher: request.StatusAllWaiterMatch, Expected: status},
+			{State: request.FailureWaiterState, Matcher: request.MissingWaiterMatch},
+		},
+	}
+	w.ApplyOptions(options...)
+
+	o, err := w.WaitWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.(*DescribeVolumesOutput).VolumeSet, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/volume/detach_volumes.html
 func (s *VolumeService) DetachVolumes(i *DetachVolumesInput) (*DetachVolumesOutput, error) {
+	return s.DetachVolumesWithContext(context.Background(), i)
+}
+
+// DetachVolumesWithContext is the same as DetachVolumes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VolumeService) DetachVolumesWithContext(ctx context.Context, i *DetachVolumesInput) (*DetachVolumesOutput, error) {
 	if i == nil {
 		i = &DetachVolumesInput{}
 	}
@@ -481,8 +624,12 @@ func (s *VolumeService) DetachVolumes(i *DetachVolumesInput) (*DetachVolumesOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -523,6 +670,12 @@ type DetachVolumesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/volume/modify_volume_attributes.html
 func (s *VolumeService) ModifyVolumeAttributes(i *ModifyVolumeAttributesInput) (*ModifyVolumeAttributesOutput, error) {
+	return s.ModifyVolumeAttributesWithContext(context.Background(), i)
+}
+
+// ModifyVolumeAttributesWithContext is the same as ModifyVolumeAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VolumeService) ModifyVolumeAttributesWithContext(ctx context.Context, i *ModifyVolumeAttributesInput) (*ModifyVolumeAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyVolumeAttributesInput{}
 	}
@@ -538,8 +691,12 @@ func (s *VolumeService) ModifyVolumeAttributes(i *ModifyVolumeAttributesInput) (
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -575,6 +732,12 @@ type ModifyVolumeAttributesOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/volume/resize_volumes.html
 func (s *VolumeService) ResizeVolumes(i *ResizeVolumesInput) (*ResizeVolumesOutput, error) {
+	return s.ResizeVolumesWithContext(context.Background(), i)
+}
+
+// ResizeVolumesWithContext is the same as ResizeVolumes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VolumeService) ResizeVolumesWithContext(ctx context.Context, i *ResizeVolumesInput) (*ResizeVolumesOutput, error) {
 	if i == nil {
 		i = &ResizeVolumesInput{}
 	}
@@ -590,8 +753,12 @@ func (s *VolumeService) ResizeVolumes(i *ResizeVolumesInput) (*ResizeVolumesOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -31,6 +32,7 @@ var _ time.Time
 type VpcBorderService struct {
 	Config     *config.Config
 	Properties *VpcBorderServiceProperties
+	Handlers   *request.Handlers
 }
 
 type VpcBorderServiceProperties struct {
@@ -43,12 +45,18 @@ func (s *QingCloudService) VpcBorder(zone string) (*VpcBorderService, error) {
 		Zone: &zone,
 	}
 
-	return &VpcBorderService{Config: s.Config, Properties: properties}, nil
+	return &VpcBorderService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // AddBorderStatics: AddBorderStatics
 
 func (s *VpcBorderService) AddBorderStatics(i *AddBorderStaticsInput) (*AddBorderStaticsOutput, error) {
+	return s.AddBorderStaticsWithContext(context.Background(), i)
+}
+
+// AddBorderStaticsWithContext is the same as AddBorderStatics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) AddBorderStaticsWithContext(ctx context.Context, i *AddBorderStaticsInput) (*AddBorderStaticsOutput, error) {
 	if i == nil {
 		i = &AddBorderStaticsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *VpcBorderService) AddBorderStatics(i *AddBorderStaticsInput) (*AddBorde
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +107,12 @@ type AddBorderStaticsOutput struct {
 // AssociateBorder: AssociateBorder
 
 func (s *VpcBorderService) AssociateBorder(i *AssociateBorderInput) (*AssociateBorderOutput, error) {
+	return s.AssociateBorderWithContext(context.Background(), i)
+}
+
+// AssociateBorderWithContext is the same as AssociateBorder with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) AssociateBorderWithContext(ctx context.Context, i *AssociateBorderInput) (*AssociateBorderOutput, error) {
 	if i == nil {
 		i = &AssociateBorderInput{}
 	}
@@ -110,8 +128,12 @@ func (s *VpcBorderService) AssociateBorder(i *AssociateBorderInput) (*AssociateB
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +165,12 @@ type AssociateBorderOutput struct {
 // ConfigBorder: ConfigBorder
 
 func (s *VpcBorderService) ConfigBorder(i *ConfigBorderInput) (*ConfigBorderOutput, error) {
+	return s.ConfigBorderWithContext(context.Background(), i)
+}
+
+// ConfigBorderWithContext is the same as ConfigBorder with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) ConfigBorderWithContext(ctx context.Context, i *ConfigBorderInput) (*ConfigBorderOutput, error) {
 	if i == nil {
 		i = &ConfigBorderInput{}
 	}
@@ -158,8 +186,12 @@ func (s *VpcBorderService) ConfigBorder(i *ConfigBorderInput) (*ConfigBorderOutp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +224,12 @@ type ConfigBorderOutput struct {
 // CreateVpcBorders: CreateVpcBorders
 
 func (s *VpcBorderService) CreateVpcBorders(i *CreateVpcBordersInput) (*CreateVpcBordersOutput, error) {
+	return s.CreateVpcBordersWithContext(context.Background(), i)
+}
+
+// CreateVpcBordersWithContext is the same as CreateVpcBorders with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) CreateVpcBordersWithContext(ctx context.Context, i *CreateVpcBordersInput) (*CreateVpcBordersOutput, error) {
 	if i == nil {
 		i = &CreateVpcBordersInput{}
 	}
@@ -207,8 +245,12 @@ func (s *VpcBorderService) CreateVpcBorders(i *CreateVpcBordersInput) (*CreateVp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +279,12 @@ type CreateVpcBordersOutput struct {
 // DeleteBorderStatics: DeleteBorderStatics
 
 func (s *VpcBorderService) DeleteBorderStatics(i *DeleteBorderStaticsInput) (*DeleteBorderStaticsOutput, error) {
+	return s.DeleteBorderStaticsWithContext(context.Background(), i)
+}
+
+// DeleteBorderStaticsWithContext is the same as DeleteBorderStatics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) DeleteBorderStaticsWithContext(ctx context.Context, i *DeleteBorderStaticsInput) (*DeleteBorderStaticsOutput, error) {
 	if i == nil {
 		i = &DeleteBorderStaticsInput{}
 	}
@@ -252,8 +300,12 @@ func (s *VpcBorderService) DeleteBorderStatics(i *DeleteBorderStaticsInput) (*De
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -281,6 +333,12 @@ type DeleteBorderStaticsOutput struct {
 // DeleteVpcBorders: DeleteVpcBorders
 
 func (s *VpcBorderService) DeleteVpcBorders(i *DeleteVpcBordersInput) (*DeleteVpcBordersOutput, error) {
+	return s.DeleteVpcBordersWithContext(context.Background(), i)
+}
+
+// DeleteVpcBordersWithContext is the same as DeleteVpcBorders with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) DeleteVpcBordersWithContext(ctx context.Context, i *DeleteVpcBordersInput) (*DeleteVpcBordersOutput, error) {
 	if i == nil {
 		i = &DeleteVpcBordersInput{}
 	}
@@ -296,8 +354,12 @@ func (s *VpcBorderService) DeleteVpcBorders(i *DeleteVpcBordersInput) (*DeleteVp
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -323,6 +385,12 @@ type DeleteVpcBordersOutput struct {
 // DescribeBorderStatics: DescribeBorderStatics
 
 func (s *VpcBorderService) DescribeBorderStatics(i *DescribeBorderStaticsInput) (*DescribeBorderStaticsOutput, error) {
+	return s.DescribeBorderStaticsWithContext(context.Background(), i)
+}
+
+// DescribeBorderStaticsWithContext is the same as DescribeBorderStatics with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) DescribeBorderStaticsWithContext(ctx context.Context, i *DescribeBorderStaticsInput) (*DescribeBorderStaticsOutput, error) {
 	if i == nil {
 		i = &DescribeBorderStaticsInput{}
 	}
@@ -338,8 +406,12 @@ func (s *VpcBorderService) DescribeBorderStatics(i *DescribeBorderStaticsInput)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -379,6 +451,12 @@ type DescribeBorderStaticsOutput struct {
 // DescribeBorderVxNets: DescribeBorderVxnets
 
 func (s *VpcBorderService) DescribeBorderVxNets(i *DescribeBorderVxNetsInput) (*DescribeBorderVxNetsOutput, error) {
+	return s.DescribeBorderVxNetsWithContext(context.Background(), i)
+}
+
+// DescribeBorderVxNetsWithContext is the same as DescribeBorderVxNets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) DescribeBorderVxNetsWithContext(ctx context.Context, i *DescribeBorderVxNetsInput) (*DescribeBorderVxNetsOutput, error) {
 	if i == nil {
 		i = &DescribeBorderVxNetsInput{}
 	}
@@ -394,8 +472,12 @@ func (s *VpcBorderService) DescribeBorderVxNets(i *DescribeBorderVxNetsInput) (*
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -426,9 +508,68 @@ type DescribeBorderVxNetsOutput struct {
 	BorderVxnet []*BorderVxnet `json:"border_vxnet_set"  name:"border_vxnet_set" location:"elements"`
 }
 
+// DescribeBorderVxNetsPaginator pages through the results of DescribeBorderVxNets.
+type DescribeBorderVxNetsPaginator struct {
+	request.Pagination
+	service *VpcBorderService
+	input   DescribeBorderVxNetsInput
+}
+
+// NewDescribeBorderVxNetsPaginator create a paginator of DescribeBorderVxNets starting at
+// the Offset of input.
+func NewDescribeBorderVxNetsPaginator(s *VpcBorderService, i *DescribeBorderVxNetsInput) *DescribeBorderVxNetsPaginator {
+	if i == nil {
+		i = &DescribeBorderVxNetsInput{}
+	}
+	return &DescribeBorderVxNetsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeBorderVxNets.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeBorderVxNetsPaginator) NextPage(ctx context.Context) (*DescribeBorderVxNetsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeBorderVxNetsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.BorderVxnet), nil)
+	return o, nil
+}
+
+// DescribeBorderVxNetsAll returns the BorderVxnet of all the pages of DescribeBorderVxNets
+// starting at the Offset of input.
+func (s *VpcBorderService) DescribeBorderVxNetsAll(ctx context.Context, i *DescribeBorderVxNetsInput) ([]*BorderVxnet, error) {
+	p := NewDescribeBorderVxNetsPaginator(s, i)
+	set := []*BorderVxnet{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.BorderVxnet...)
+	}
+	return set, nil
+}
+
 // DescribeVpcBorders: DescribeVpcBorders
 
 func (s *VpcBorderService) DescribeVpcBorders(i *DescribeVpcBordersInput) (*DescribeVpcBordersOutput, error) {
+	return s.DescribeVpcBordersWithContext(context.Background(), i)
+}
+
+// DescribeVpcBordersWithContext is the same as DescribeVpcBorders with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) DescribeVpcBordersWithContext(ctx context.Context, i *DescribeVpcBordersInput) (*DescribeVpcBordersOutput, error) {
 	if i == nil {
 		i = &DescribeVpcBordersInput{}
 	}
@@ -444,8 +585,12 @@ func (s *VpcBorderService) DescribeVpcBorders(i *DescribeVpcBordersInput) (*Desc
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -480,9 +625,68 @@ type DescribeVpcBordersOutput struct {
 	VpcBorderSet []*VpcBorder `json:"vpc_border_set" name:"vpc_border_set" location:"elements"`
 }
 
+// DescribeVpcBordersPaginator pages through the results of DescribeVpcBorders.
+type DescribeVpcBordersPaginator struct {
+	request.Pagination
+	service *VpcBorderService
+	input   DescribeVpcBordersInput
+}
+
+// NewDescribeVpcBordersPaginator create a paginator of DescribeVpcBorders starting at
+// the Offset of input.
+func NewDescribeVpcBordersPaginator(s *VpcBorderService, i *DescribeVpcBordersInput) *DescribeVpcBordersPaginator {
+	if i == nil {
+		i = &DescribeVpcBordersInput{}
+	}
+	return &DescribeVpcBordersPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeVpcBorders.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeVpcBordersPaginator) NextPage(ctx context.Context) (*DescribeVpcBordersOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeVpcBordersWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.VpcBorderSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeVpcBordersAll returns the VpcBorderSet of all the pages of DescribeVpcBorders
+// starting at the Offset of input.
+func (s *VpcBorderService) DescribeVpcBordersAll(ctx context.Context, i *DescribeVpcBordersInput) ([]*VpcBorder, error) {
+	p := NewDescribeVpcBordersPaginator(s, i)
+	set := []*VpcBorder{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.VpcBorderSet...)
+	}
+	return set, nil
+}
+
 // DissociateBorder: DissociateBorder
 
 func (s *VpcBorderService) DissociateBorder(i *DissociateBorderInput) (*DissociateBorderOutput, error) {
+	return s.DissociateBorderWithContext(context.Background(), i)
+}
+
+// DissociateBorderWithContext is the same as DissociateBorder with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) DissociateBorderWithContext(ctx context.Context, i *DissociateBorderInput) (*DissociateBorderOutput, error) {
 	if i == nil {
 		i = &DissociateBorderInput{}
 	}
@@ -498,8 +702,12 @@ func (s *VpcBorderService) DissociateBorder(i *DissociateBorderInput) (*Dissocia
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -531,6 +739,12 @@ type DissociateBorderOutput struct {
 // JoinBorder: JoinBorder
 
 func (s *VpcBorderService) JoinBorder(i *JoinBorderInput) (*JoinBorderOutput, error) {
+	return s.JoinBorderWithContext(context.Background(), i)
+}
+
+// JoinBorderWithContext is the same as JoinBorder with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) JoinBorderWithContext(ctx context.Context, i *JoinBorderInput) (*JoinBorderOutput, error) {
 	if i == nil {
 		i = &JoinBorderInput{}
 	}
@@ -546,8 +760,12 @@ func (s *VpcBorderService) JoinBorder(i *JoinBorderInput) (*JoinBorderOutput, er
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -581,6 +799,12 @@ type JoinBorderOutput struct {
 // LeaveBorder: LeaveBorder
 
 func (s *VpcBorderService) LeaveBorder(i *LeaveBorderInput) (*LeaveBorderOutput, error) {
+	return s.LeaveBorderWithContext(context.Background(), i)
+}
+
+// LeaveBorderWithContext is the same as LeaveBorder with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) LeaveBorderWithContext(ctx context.Context, i *LeaveBorderInput) (*LeaveBorderOutput, error) {
 	if i == nil {
 		i = &LeaveBorderInput{}
 	}
@@ -596,8 +820,12 @@ func (s *VpcBorderService) LeaveBorder(i *LeaveBorderInput) (*LeaveBorderOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -632,6 +860,12 @@ type LeaveBorderOutput struct {
 // ModifyBorderAttributes: ModifyBorderAttributes
 
 func (s *VpcBorderService) ModifyBorderAttributes(i *ModifyBorderAttributesInput) (*ModifyBorderAttributesOutput, error) {
+	return s.ModifyBorderAttributesWithContext(context.Background(), i)
+}
+
+// ModifyBorderAttributesWithContext is the same as ModifyBorderAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VpcBorderService) ModifyBorderAttributesWithContext(ctx context.Context, i *ModifyBorderAttributesInput) (*ModifyBorderAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyBorderAttributesInput{}
 	}
@@ -647,8 +881,12 @@ func (s *VpcBorderService) ModifyBorderAttributes(i *ModifyBorderAttributesInput
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -32,6 +33,7 @@ var _ time.Time
 type VxNetService struct {
 	Config     *config.Config
 	Properties *VxNetServiceProperties
+	Handlers   *request.Handlers
 }
 
 type VxNetServiceProperties struct {
@@ -44,11 +46,17 @@ func (s *QingCloudService) VxNet(zone string) (*VxNetService, error) {
 		Zone: &zone,
 	}
 
-	return &VxNetService{Config: s.Config, Properties: properties}, nil
+	return &VxNetService{Config: s.Config, Properties: properties, Handlers: s.Handlers.Copy()}, nil
 }
 
 // Documentation URL: https://docs.qingcloud.com/api/vxnet/create_vxnets.html
 func (s *VxNetService) CreateVxNets(i *CreateVxNetsInput) (*CreateVxNetsOutput, error) {
+	return s.CreateVxNetsWithContext(context.Background(), i)
+}
+
+// CreateVxNetsWithContext is the same as CreateVxNets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VxNetService) CreateVxNetsWithContext(ctx context.Context, i *CreateVxNetsInput) (*CreateVxNetsOutput, error) {
 	if i == nil {
 		i = &CreateVxNetsInput{}
 	}
@@ -64,8 +72,12 @@ func (s *VxNetService) CreateVxNets(i *CreateVxNetsInput) (*CreateVxNetsOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +133,12 @@ type CreateVxNetsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vxnet/delete_vxnets.html
 func (s *VxNetService) DeleteVxNets(i *DeleteVxNetsInput) (*DeleteVxNetsOutput, error) {
+	return s.DeleteVxNetsWithContext(context.Background(), i)
+}
+
+// DeleteVxNetsWithContext is the same as DeleteVxNets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VxNetService) DeleteVxNetsWithContext(ctx context.Context, i *DeleteVxNetsInput) (*DeleteVxNetsOutput, error) {
 	if i == nil {
 		i = &DeleteVxNetsInput{}
 	}
@@ -136,8 +154,12 @@ func (s *VxNetService) DeleteVxNets(i *DeleteVxNetsInput) (*DeleteVxNetsOutput,
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +192,12 @@ type DeleteVxNetsOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vxnet/describe_vxnet_instances.html
 func (s *VxNetService) DescribeVxNetInstances(i *DescribeVxNetInstancesInput) (*DescribeVxNetInstancesOutput, error) {
+	return s.DescribeVxNetInstancesWithContext(context.Background(), i)
+}
+
+// DescribeVxNetInstancesWithContext is the same as DescribeVxNetInstances with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VxNetService) DescribeVxNetInstancesWithContext(ctx context.Context, i *DescribeVxNetInstancesInput) (*DescribeVxNetInstancesOutput, error) {
 	if i == nil {
 		i = &DescribeVxNetInstancesInput{}
 	}
@@ -185,8 +213,12 @@ func (s *VxNetService) DescribeVxNetInstances(i *DescribeVxNetInstancesInput) (*
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -224,8 +256,67 @@ type DescribeVxNetInstancesOutput struct {
 	TotalCount  *int        `json:"total_count" name:"total_count" location:"elements"`
 }
 
+// DescribeVxNetInstancesPaginator pages through the results of DescribeVxNetInstances.
+type DescribeVxNetInstancesPaginator struct {
+	request.Pagination
+	service *VxNetService
+	input   DescribeVxNetInstancesInput
+}
+
+// NewDescribeVxNetInstancesPaginator create a paginator of DescribeVxNetInstances starting at
+// the Offset of input.
+func NewDescribeVxNetInstancesPaginator(s *VxNetService, i *DescribeVxNetInstancesInput) *DescribeVxNetInstancesPaginator {
+	if i == nil {
+		i = &DescribeVxNetInstancesInput{}
+	}
+	return &DescribeVxNetInstancesPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeVxNetInstances.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeVxNetInstancesPaginator) NextPage(ctx context.Context) (*DescribeVxNetInstancesOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeVxNetInstancesWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.InstanceSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeVxNetInstancesAll returns the InstanceSet of all the pages of DescribeVxNetInstances
+// starting at the Offset of input.
+func (s *VxNetService) DescribeVxNetInstancesAll(ctx context.Context, i *DescribeVxNetInstancesInput) ([]*Instance, error) {
+	p := NewDescribeVxNetInstancesPaginator(s, i)
+	set := []*Instance{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.InstanceSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/vxnet/describe_vxnets.html
 func (s *VxNetService) DescribeVxNets(i *DescribeVxNetsInput) (*DescribeVxNetsOutput, error) {
+	return s.DescribeVxNetsWithContext(context.Background(), i)
+}
+
+// DescribeVxNetsWithContext is the same as DescribeVxNets with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VxNetService) DescribeVxNetsWithContext(ctx context.Context, i *DescribeVxNetsInput) (*DescribeVxNetsOutput, error) {
 	if i == nil {
 		i = &DescribeVxNetsInput{}
 	}
@@ -241,8 +332,12 @@ func (s *VxNetService) DescribeVxNets(i *DescribeVxNetsInput) (*DescribeVxNetsOu
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -318,8 +413,67 @@ type DescribeVxNetsOutput struct {
 	VxNetSet   []*VxNet `json:"vxnet_set" name:"vxnet_set" location:"elements"`
 }
 
+// DescribeVxNetsPaginator pages through the results of DescribeVxNets.
+type DescribeVxNetsPaginator struct {
+	request.Pagination
+	service *VxNetService
+	input   DescribeVxNetsInput
+}
+
+// NewDescribeVxNetsPaginator create a paginator of DescribeVxNets starting at
+// the Offset of input.
+func NewDescribeVxNetsPaginator(s *VxNetService, i *DescribeVxNetsInput) *DescribeVxNetsPaginator {
+	if i == nil {
+		i = &DescribeVxNetsInput{}
+	}
+	return &DescribeVxNetsPaginator{
+		Pagination: request.NewPagination(i.Offset, i.Limit),
+		service:    s,
+		input:      *i,
+	}
+}
+
+// NextPage fetches the next page of DescribeVxNets.
+// It returns request.ErrNoMorePages after the last page.
+func (p *DescribeVxNetsPaginator) NextPage(ctx context.Context) (*DescribeVxNetsOutput, error) {
+	if !p.HasMorePages() {
+		return nil, request.ErrNoMorePages
+	}
+
+	i := p.input
+	i.Offset = p.Offset()
+	o, err := p.service.DescribeVxNetsWithContext(ctx, &i)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Advance(len(o.VxNetSet), o.TotalCount)
+	return o, nil
+}
+
+// DescribeVxNetsAll returns the VxNetSet of all the pages of DescribeVxNets
+// starting at the Offset of input.
+func (s *VxNetService) DescribeVxNetsAll(ctx context.Context, i *DescribeVxNetsInput) ([]*VxNet, error) {
+	p := NewDescribeVxNetsPaginator(s, i)
+	set := []*VxNet{}
+	for p.HasMorePages() {
+		o, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, o.VxNetSet...)
+	}
+	return set, nil
+}
+
 // Documentation URL: https://docs.qingcloud.com/api/vxnet/join_vxnet.html
 func (s *VxNetService) JoinVxNet(i *JoinVxNetInput) (*JoinVxNetOutput, error) {
+	return s.JoinVxNetWithContext(context.Background(), i)
+}
+
+// JoinVxNetWithContext is the same as JoinVxNet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VxNetService) JoinVxNetWithContext(ctx context.Context, i *JoinVxNetInput) (*JoinVxNetOutput, error) {
 	if i == nil {
 		i = &JoinVxNetInput{}
 	}
@@ -335,8 +489,12 @@ func (s *VxNetService) JoinVxNet(i *JoinVxNetInput) (*JoinVxNetOutput, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -377,6 +535,12 @@ type JoinVxNetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vxnet/leave_vxnet.html
 func (s *VxNetService) LeaveVxNet(i *LeaveVxNetInput) (*LeaveVxNetOutput, error) {
+	return s.LeaveVxNetWithContext(context.Background(), i)
+}
+
+// LeaveVxNetWithContext is the same as LeaveVxNet with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VxNetService) LeaveVxNetWithContext(ctx context.Context, i *LeaveVxNetInput) (*LeaveVxNetOutput, error) {
 	if i == nil {
 		i = &LeaveVxNetInput{}
 	}
@@ -392,8 +556,12 @@ func (s *VxNetService) LeaveVxNet(i *LeaveVxNetInput) (*LeaveVxNetOutput, error)
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -434,6 +602,12 @@ type LeaveVxNetOutput struct {
 
 // Documentation URL: https://docs.qingcloud.com/api/vxnet/modify_vxnet_attributes.html
 func (s *VxNetService) ModifyVxNetAttributes(i *ModifyVxNetAttributesInput) (*ModifyVxNetAttributesOutput, error) {
+	return s.ModifyVxNetAttributesWithContext(context.Background(), i)
+}
+
+// ModifyVxNetAttributesWithContext is the same as ModifyVxNetAttributes with the addition of
+// the ability to pass a context for cancellation and deadlines.
+func (s *VxNetService) ModifyVxNetAttributesWithContext(ctx context.Context, i *ModifyVxNetAttributesInput) (*ModifyVxNetAttributesOutput, error) {
 	if i == nil {
 		i = &ModifyVxNetAttributesInput{}
 	}
@@ -449,8 +623,12 @@ func (s *VxNetService) ModifyVxNetAttributes(i *ModifyVxNetAttributesInput) (*Mo
 	if err != nil {
 		return nil, err
 	}
+	if s.Handlers != nil {
+		r.Handlers = s.Handlers.Copy()
+	}
+	r.Handlers.Merge(request.ConfigHandlers(s.Config))
 
-	err = r.Send()
+	err = r.SendWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
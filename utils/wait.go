@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -23,6 +24,12 @@ func NewTimeoutError(timeout time.Duration) *TimeoutError {
 
 // WaitForSpecificOrError wait a function return true or error.
 func WaitForSpecificOrError(f func() (bool, error), timeout time.Duration, waitInterval time.Duration) error {
+	return WaitForSpecificOrErrorWithContext(context.Background(), f, timeout, waitInterval)
+}
+
+// WaitForSpecificOrErrorWithContext wait a function return true or error,
+// it returns the context error as soon as the context is done.
+func WaitForSpecificOrErrorWithContext(ctx context.Context, f func() (bool, error), timeout time.Duration, waitInterval time.Duration) error {
 	ticker := time.NewTicker(waitInterval)
 	defer ticker.Stop()
 	timer := time.NewTimer(timeout)
@@ -40,6 +47,8 @@ func WaitForSpecificOrError(f func() (bool, error), timeout time.Duration, waitI
 			}
 		case <-timer.C:
 			return NewTimeoutError(timeout)
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 }
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"errors"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -47,3 +48,18 @@ func TestWaitForSpecificOrError(t *testing.T) {
 	assert.Equal(t, timeout, tErr.timeout)
 	assert.Equal(t, 10, times)
 }
+
+func TestWaitForSpecificOrErrorWithContext(t *testing.T) {
+	waitInterval := 100 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	times := 0
+	err := WaitForSpecificOrErrorWithContext(ctx, func() (bool, error) {
+		times++
+		if times == 2 {
+			cancel()
+		}
+		return false, nil
+	}, 10*waitInterval, waitInterval)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 2, times)
+}